@@ -0,0 +1,142 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	ApprovalPending  = "pending"
+	ApprovalApproved = "approved"
+	ApprovalRejected = "rejected"
+)
+
+// JobApproval is the decision of a data provider on a job using its datasets, the job runs once every provider approved it
+type JobApproval struct {
+	gorm.Model
+	JobUUID  string `gorm:"job_uuid;uniqueIndex:idx_job_provider;size:64" json:"job_uuid"`
+	Provider string `gorm:"provider;uniqueIndex:idx_job_provider;size:32" json:"provider"`
+	Decision string `gorm:"decision;index" json:"decision"`
+	Comment  string `gorm:"comment" json:"comment"`
+}
+
+func (JobApproval) TableName() string {
+	return "job_approvals"
+}
+
+// AuditLog records who did what to a job, rows are never modified
+type AuditLog struct {
+	gorm.Model
+	JobUUID string `gorm:"job_uuid;index" json:"job_uuid"`
+	Actor   string `gorm:"actor" json:"actor"`
+	Action  string `gorm:"action" json:"action"`
+	Detail  string `gorm:"detail;type:text" json:"detail"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+// CreateJobApprovals requests the approvals of a job and records the request in the audit log
+func CreateJobApprovals(records []*JobApproval, logs []*AuditLog) error {
+	timestamp := time.Now()
+	for _, r := range records {
+		r.CreatedAt = timestamp
+		r.UpdatedAt = timestamp
+	}
+	for _, l := range logs {
+		l.CreatedAt = timestamp
+		l.UpdatedAt = timestamp
+	}
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&records).Error; err != nil {
+			return err
+		}
+		return tx.Create(&logs).Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to insert job approvals ")
+	}
+	return nil
+}
+
+// DecideJobApproval records the decision of a pending approval together with its audit log,
+// it returns false if the approval was already decided
+func DecideJobApproval(a *JobApproval, log *AuditLog) (bool, error) {
+	decided := false
+	timestamp := time.Now()
+	log.CreatedAt = timestamp
+	log.UpdatedAt = timestamp
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(JobApproval{}).Where("id = ? AND decision = ?", a.ID, ApprovalPending).
+			Updates(JobApproval{Decision: a.Decision, Comment: a.Comment})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		decided = true
+		return tx.Create(log).Error
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to update job approval ")
+	}
+	return decided, nil
+}
+
+func QueryJobApprovals(jobUUID string) ([]*JobApproval, error) {
+	var res []*JobApproval
+	if err := DB.Model(JobApproval{}).Where("job_uuid = ?", jobUUID).Order("id").Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query job approvals ")
+	}
+	return res, nil
+}
+
+// QueryApprovalsByProvider returns the approvals a provider was asked for, pending ones first
+func QueryApprovalsByProvider(provider string, page, pageSize int64) ([]*JobApproval, int64, error) {
+	db := DB.Model(JobApproval{}).Where("provider = ?", provider)
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count job approvals ")
+	}
+	var res []*JobApproval
+	if err := db.Order("decision = 'pending' DESC, id DESC").Limit(int(pageSize)).Offset(int(pageSize * (page - 1))).Find(&res).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to query job approvals ")
+	}
+	return res, total, nil
+}
+
+func CreateAuditLog(log *AuditLog) error {
+	timestamp := time.Now()
+	log.CreatedAt = timestamp
+	log.UpdatedAt = timestamp
+	if err := DB.Create(log).Error; err != nil {
+		return errors.Wrap(err, "failed to insert audit log ")
+	}
+	return nil
+}
+
+func QueryAuditLogs(jobUUID string) ([]*AuditLog, error) {
+	var res []*AuditLog
+	if err := DB.Model(AuditLog{}).Where("job_uuid = ?", jobUUID).Order("id").Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query audit logs ")
+	}
+	return res, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	// AttemptLaunching is the persisted intent to create the VM of the attempt, the reconciliation
+	// completes the launches that stay in this state
+	AttemptLaunching = "launching"
+	AttemptRunning   = "running"
+	AttemptFinished  = "finished"
+	AttemptPreempted = "preempted"
+	AttemptFailed    = "failed"
+)
+
+// JobAttempt is one launch of the confidential VM of a job, spot jobs are relaunched after a preemption
+type JobAttempt struct {
+	gorm.Model
+	JobUUID      string `gorm:"job_uuid;uniqueIndex:idx_job_attempt;size:64" json:"job_uuid"`
+	Attempt      int    `gorm:"attempt;uniqueIndex:idx_job_attempt" json:"attempt"`
+	InstanceName string `gorm:"instance_name" json:"instance_name"`
+	Spot         bool   `gorm:"spot" json:"spot"`
+	Zone         string `gorm:"zone" json:"zone"`
+	Outcome      string `gorm:"outcome" json:"outcome"`
+	Reason       string `gorm:"reason;type:text" json:"reason"`
+}
+
+func (JobAttempt) TableName() string {
+	return "job_attempts"
+}
+
+func CreateJobAttempt(a *JobAttempt) error {
+	timestamp := time.Now()
+	a.CreatedAt = timestamp
+	a.UpdatedAt = timestamp
+	if err := DB.Create(a).Error; err != nil {
+		return errors.Wrap(err, "failed to insert job attempt")
+	}
+	return nil
+}
+
+// StartJobAttempt records that the VM of the launching attempt was created in the zone
+func StartJobAttempt(jobUUID string, attempt int, zone string) error {
+	err := DB.Model(&JobAttempt{}).
+		Where("job_uuid = ? AND attempt = ? AND outcome IN ?", jobUUID, attempt, []string{AttemptLaunching, AttemptRunning}).
+		Updates(map[string]interface{}{"outcome": AttemptRunning, "zone": zone, "updated_at": time.Now()}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to update job attempt")
+	}
+	return nil
+}
+
+// EndJobAttempt records the outcome of the launching or running attempt of the job, it does nothing if the attempt
+// already ended
+func EndJobAttempt(jobUUID string, attempt int, outcome string, reason string) error {
+	err := DB.Model(&JobAttempt{}).
+		Where("job_uuid = ? AND attempt = ? AND outcome IN ?", jobUUID, attempt, []string{AttemptLaunching, AttemptRunning}).
+		Updates(map[string]interface{}{"outcome": outcome, "reason": reason, "updated_at": time.Now()}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to update job attempt")
+	}
+	return nil
+}
+
+// QueryStaleJobAttempts returns the attempts in the outcome that were not updated since before
+func QueryStaleJobAttempts(outcome string, before time.Time) ([]*JobAttempt, error) {
+	var res []*JobAttempt
+	if err := DB.Where("outcome = ? AND updated_at < ?", outcome, before).Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query job attempts")
+	}
+	return res, nil
+}
+
+// ClaimJobAttempt takes the attempt over by touching it if nobody else did since it was read,
+// it returns false if another replica claimed it first
+func ClaimJobAttempt(a *JobAttempt) (bool, error) {
+	result := DB.Model(&JobAttempt{}).Where("id = ? AND updated_at = ?", a.ID, a.UpdatedAt).Update("updated_at", time.Now())
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to claim job attempt")
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func QueryJobAttempt(jobUUID string, attempt int) (*JobAttempt, error) {
+	var res JobAttempt
+	if err := DB.Where("job_uuid = ? AND attempt = ?", jobUUID, attempt).First(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query job attempt")
+	}
+	return &res, nil
+}
+
+func QueryJobAttempts(jobUUID string) ([]*JobAttempt, error) {
+	var res []*JobAttempt
+	if err := DB.Where("job_uuid = ?", jobUUID).Order("attempt").Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query job attempts")
+	}
+	return res, nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type Dataset struct {
+	gorm.Model
+	Name            string `gorm:"name;uniqueIndex;size:32" json:"name"`
+	Provider        string `gorm:"provider" json:"provider"`
+	Description     string `gorm:"description" json:"description"`
+	StoragePath     string `gorm:"storage_path" json:"storage_path"`
+	KeyId           string `gorm:"key_id" json:"key_id"`
+	AccessPolicy    string `gorm:"access_policy;type:text" json:"access_policy"`
+	RequireApproval bool   `gorm:"require_approval" json:"require_approval"`
+	// DpEpsilonBudget and DpDeltaBudget are the default DP budget of each analyst, 0 means no accounting
+	DpEpsilonBudget float64 `gorm:"dp_epsilon_budget" json:"dp_epsilon_budget"`
+	DpDeltaBudget   float64 `gorm:"dp_delta_budget" json:"dp_delta_budget"`
+}
+
+func (Dataset) TableName() string {
+	return "datasets"
+}
+
+// JobDataset records a dataset a job declared, the key of the dataset is bound to the job while it runs.
+// PreviewVersion is the stage-1 preview the job was developed against, 0 if the dataset had none
+type JobDataset struct {
+	gorm.Model
+	JobUUID        string `gorm:"job_uuid;index" json:"job_uuid"`
+	DatasetName    string `gorm:"dataset_name" json:"dataset_name"`
+	PreviewVersion int64  `gorm:"preview_version" json:"preview_version"`
+}
+
+func (JobDataset) TableName() string {
+	return "job_datasets"
+}
+
+// DatasetPreview is a stage-1 variant of a dataset, such as synthetic or sampled data, that notebook users can explore
+type DatasetPreview struct {
+	gorm.Model
+	DatasetName string `gorm:"dataset_name;uniqueIndex:idx_dataset_version;size:32" json:"dataset_name"`
+	Version     int64  `gorm:"version;uniqueIndex:idx_dataset_version" json:"version"`
+	Kind        string `gorm:"kind" json:"kind"`
+	Description string `gorm:"description" json:"description"`
+	FileName    string `gorm:"file_name" json:"file_name"`
+	Size        int64  `gorm:"size" json:"size"`
+	Sha256      string `gorm:"sha256" json:"sha256"`
+}
+
+func (DatasetPreview) TableName() string {
+	return "dataset_previews"
+}
+
+func CreateDataset(d *Dataset) error {
+	timestamp := time.Now()
+	d.UpdatedAt = timestamp
+	d.CreatedAt = timestamp
+	err := DB.Create(d).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to insert dataset into dataset table ")
+	}
+	return nil
+}
+
+func QueryDatasetByName(name string) (*Dataset, error) {
+	var res Dataset
+	if err := DB.Model(Dataset{}).Where("name = ?", name).First(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query dataset ")
+	}
+	return &res, nil
+}
+
+func QueryDatasetsByNames(names []string) ([]*Dataset, error) {
+	var res []*Dataset
+	if err := DB.Model(Dataset{}).Where("name IN ?", names).Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query datasets ")
+	}
+	return res, nil
+}
+
+func QueryDatasetsByProvider(provider string, page, pageSize int64) ([]*Dataset, int64, error) {
+	db := DB.Model(Dataset{})
+	if len(provider) != 0 {
+		db = db.Where("provider = ?", provider)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count datasets ")
+	}
+	var res []*Dataset
+	if err := db.Order("id DESC").Limit(int(pageSize)).Offset(int(pageSize * (page - 1))).Find(&res).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to query datasets ")
+	}
+	return res, total, nil
+}
+
+func CreateJobDatasets(records []*JobDataset) error {
+	if len(records) == 0 {
+		return nil
+	}
+	timestamp := time.Now()
+	for _, r := range records {
+		r.CreatedAt = timestamp
+		r.UpdatedAt = timestamp
+	}
+	if err := DB.Create(&records).Error; err != nil {
+		return errors.Wrap(err, "failed to insert job datasets ")
+	}
+	return nil
+}
+
+// QueryJobDatasets returns the declared datasets of each job
+func QueryJobDatasets(jobUUIDs []string) (map[string][]*JobDataset, error) {
+	res := map[string][]*JobDataset{}
+	if len(jobUUIDs) == 0 {
+		return res, nil
+	}
+	var records []*JobDataset
+	if err := DB.Model(JobDataset{}).Where("job_uuid IN ?", jobUUIDs).Order("id").Find(&records).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query job datasets ")
+	}
+	for _, r := range records {
+		res[r.JobUUID] = append(res[r.JobUUID], r)
+	}
+	return res, nil
+}
+
+// CreateDatasetPreview stores the preview as the next version of the dataset's previews
+func CreateDatasetPreview(p *DatasetPreview) error {
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		var latest int64
+		if err := tx.Model(DatasetPreview{}).Where("dataset_name = ?", p.DatasetName).Select("COALESCE(MAX(version), 0)").Scan(&latest).Error; err != nil {
+			return err
+		}
+		timestamp := time.Now()
+		p.Version = latest + 1
+		p.CreatedAt = timestamp
+		p.UpdatedAt = timestamp
+		return tx.Create(p).Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to insert preview into dataset preview table ")
+	}
+	return nil
+}
+
+func UpdateDatasetPreview(p *DatasetPreview) error {
+	if err := DB.Model(p).Updates(DatasetPreview{Size: p.Size, Sha256: p.Sha256}).Error; err != nil {
+		return errors.Wrap(err, "failed to update dataset preview ")
+	}
+	return nil
+}
+
+// DeleteDatasetPreview removes a preview whose upload failed, the version can be used again
+func DeleteDatasetPreview(p *DatasetPreview) error {
+	if err := DB.Unscoped().Delete(p).Error; err != nil {
+		return errors.Wrap(err, "failed to delete dataset preview ")
+	}
+	return nil
+}
+
+func QueryDatasetPreviews(name string) ([]*DatasetPreview, error) {
+	var res []*DatasetPreview
+	if err := DB.Model(DatasetPreview{}).Where("dataset_name = ?", name).Order("version DESC").Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query dataset previews ")
+	}
+	return res, nil
+}
+
+// QueryDatasetPreview returns the given version of the dataset's preview, or the latest one if version is 0
+func QueryDatasetPreview(name string, version int64) (*DatasetPreview, error) {
+	db := DB.Model(DatasetPreview{}).Where("dataset_name = ?", name)
+	if version > 0 {
+		db = db.Where("version = ?", version)
+	}
+	var res DatasetPreview
+	if err := db.Order("version DESC").First(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query dataset preview ")
+	}
+	return &res, nil
+}
@@ -39,7 +39,7 @@ func Init() {
 
 	// Auto database schema migration
 	// This has caveat: see https://gorm.io/docs/migration.html
-	err = DB.AutoMigrate(&Job{})
+	err = DB.AutoMigrate(&Job{}, &AttestationPolicy{}, &Dataset{}, &JobDataset{}, &DatasetPreview{}, &JobApproval{}, &AuditLog{}, &JobOutput{}, &PrivacyBudget{}, &PrivacyLedgerEntry{}, &JobAttempt{})
 	if err != nil {
 		panic(err)
 	}
@@ -15,10 +15,14 @@
 package db
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/jobstate"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
 )
 
 type Job struct {
@@ -32,6 +36,22 @@ type Job struct {
 	AttestationReport string `gorm:"attestation_report" json:"attestation_report"`
 	JobStatus         int    `gorm:"job_status" json:"job_status"`
 	InstanceName      string `gorm:"instance_name" json:"instance_name"`
+	FailureReason     string `gorm:"failure_reason" json:"failure_reason"`
+	PolicyProvider    string `gorm:"policy_provider" json:"policy_provider"`
+	PolicyVersion     int64  `gorm:"policy_version" json:"policy_version"`
+	TEEType           string `gorm:"tee_type;default:SEV" json:"tee_type"`
+	MachineProfile    string `gorm:"machine_profile" json:"machine_profile"`
+	Cpus              int    `gorm:"cpus" json:"cpus"`
+	Spot              bool   `gorm:"spot" json:"spot"`
+	NetworkMode       string `gorm:"network_mode" json:"network_mode"`
+	// Attempts is the number of launches of the confidential VM of the job
+	Attempts int `gorm:"attempts" json:"attempts"`
+	// Zone is where the confidential VM of the latest attempt runs
+	Zone string `gorm:"zone" json:"zone"`
+	// Version is incremented by every update, an update of a job read before another one is rejected
+	Version int64 `gorm:"version;not null;default:0" json:"version"`
+	// Quarantine is the progress of the quarantine of the outputs once the job finished, see QuarantinePending
+	Quarantine string `gorm:"quarantine;index;size:16" json:"quarantine"`
 }
 
 func (Job) TableName() string {
@@ -49,14 +69,67 @@ func CreateJob(job *Job) error {
 	return nil
 }
 
+// UpdateJob saves the job but its status, which only changes through UpdateJobStatus
 func UpdateJob(j *Job) error {
-	result := DB.Model(j).Updates(Job{JobStatus: j.JobStatus, DockerImageDigest: j.DockerImageDigest, DockerImage: j.DockerImage, AttestationReport: j.AttestationReport, InstanceName: j.InstanceName})
+	return updateJob(j, DB.Model(Job{}).Where("id = ? AND version = ?", j.ID, j.Version), Job{})
+}
+
+// UpdateJobStatus saves the job and moves it to its status from the given status, the transition must have
+// been checked by the state machine
+func UpdateJobStatus(j *Job, from int) error {
+	return updateJob(j, DB.Model(Job{}).Where("id = ? AND version = ? AND job_status = ?", j.ID, j.Version, from), Job{JobStatus: j.JobStatus})
+}
+
+// updateJob saves the fields of the job if nobody updated it since it was read, and increments its version
+func updateJob(j *Job, query *gorm.DB, fields Job) error {
+	fields.DockerImageDigest = j.DockerImageDigest
+	fields.DockerImage = j.DockerImage
+	fields.AttestationReport = j.AttestationReport
+	fields.InstanceName = j.InstanceName
+	fields.FailureReason = j.FailureReason
+	fields.Attempts = j.Attempts
+	fields.Zone = j.Zone
+	fields.Quarantine = j.Quarantine
+	fields.Version = j.Version + 1
+	result := query.Updates(fields)
 	if result.Error != nil {
-		return errors.Wrap(result.Error, "failed to update job %v")
+		return errors.Wrap(result.Error, "failed to update job")
 	}
+	if result.RowsAffected == 0 {
+		return errno.JobVersionConflictErr.WithMessage(fmt.Sprintf("job %s was updated by another request", j.UUID))
+	}
+	j.Version++
 	return nil
 }
 
+// QueryJobsToQuarantine returns the finished jobs whose outputs are not all quarantined yet
+// and that were not updated since before
+func QueryJobsToQuarantine(before time.Time) ([]*Job, error) {
+	var res []*Job
+	if err := DB.Model(Job{}).Where("quarantine = ? AND updated_at < ?", QuarantinePending, before).Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query jobs to quarantine")
+	}
+	return res, nil
+}
+
+// TouchJob refreshes the update time of the job while it's in the status, the job and its version are unchanged
+func TouchJob(uuid string, status int) error {
+	err := DB.Model(Job{}).Where("uuid = ? AND job_status = ?", uuid, status).Update("updated_at", time.Now()).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to touch job")
+	}
+	return nil
+}
+
+// QueryStaleJobs returns the jobs in the status that were not updated since before
+func QueryStaleJobs(status int, before time.Time) ([]*Job, error) {
+	var res []*Job
+	if err := DB.Model(Job{}).Where("job_status = ? AND updated_at < ?", status, before).Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query stale jobs")
+	}
+	return res, nil
+}
+
 func QueryJobsByCreator(creator string, page, pageSize int64) ([]*Job, int64, error) {
 	db := DB.Model(Job{})
 	if len(creator) != 0 {
@@ -96,9 +169,25 @@ func QueryJobByUUIDAndCreator(creator string, uuid string) (*Job, error) {
 	return &res, nil
 }
 
+func QueryJobById(jobId int64) (*Job, error) {
+	var res Job
+	if err := DB.Model(Job{}).Where("id = ?", jobId).First(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query jobs or it doesn't exist")
+	}
+	return &res, nil
+}
+
+func QueryJobByUUID(uuid string) (*Job, error) {
+	var res Job
+	if err := DB.Model(Job{}).Where("uuid = ?", uuid).First(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query jobs ")
+	}
+	return &res, nil
+}
+
 func GetInProgressJobs(creator string) ([]*Job, error) {
 	var res []*Job
-	if err := DB.Model(Job{}).Where("creator = ? AND job_status in (1, 3, 4)", creator).Find(&res).Error; err != nil {
+	if err := DB.Model(Job{}).Where("creator = ? AND job_status in ?", creator, jobstate.InProgress()).Find(&res).Error; err != nil {
 		return nil, errors.Wrap(err, "failed to find finished job status")
 	}
 	return res, nil
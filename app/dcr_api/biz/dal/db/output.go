@@ -0,0 +1,151 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	OutputQuarantined = "quarantined"
+	OutputBlocked     = "blocked"
+	OutputReleased    = "released"
+	OutputRedacted    = "redacted"
+	OutputRejected    = "rejected"
+)
+
+// the progress of the quarantine of the outputs of a finished job, the jobs finished
+// before the outputs were quarantined have none
+const (
+	QuarantinePending = "pending"
+	QuarantineDone    = "done"
+)
+
+// JobOutput is an output of a job held in quarantine until it's released, Report is the JSON inspection report.
+// Blocked outputs failed the automated checks and are only released by a reviewer
+type JobOutput struct {
+	gorm.Model
+	JobUUID  string `gorm:"job_uuid;uniqueIndex:idx_job_file;size:64" json:"job_uuid"`
+	Creator  string `gorm:"creator" json:"creator"`
+	FileName string `gorm:"file_name;uniqueIndex:idx_job_file;size:128" json:"file_name"`
+	Size     int64  `gorm:"size" json:"size"`
+	Sha256   string `gorm:"sha256" json:"sha256"`
+	Status   string `gorm:"status;index" json:"status"`
+	Report   string `gorm:"report;type:text" json:"report"`
+	Reviewer string `gorm:"reviewer" json:"reviewer"`
+	Comment  string `gorm:"comment" json:"comment"`
+	// AttestedSha256 is the hash of the output in the attested manifest, empty if the output isn't attested
+	AttestedSha256 string `gorm:"attested_sha256" json:"attested_sha256"`
+}
+
+func (JobOutput) TableName() string {
+	return "job_outputs"
+}
+
+func IsOutputReleased(status string) bool {
+	return status == OutputReleased || status == OutputRedacted
+}
+
+// UpsertJobOutput inserts the output, or replaces the inspection of the output if it's still waiting for a review
+// so the quarantine of an output can be retried. It returns false if the output was already reviewed
+func UpsertJobOutput(o *JobOutput) (bool, error) {
+	saved := false
+	timestamp := time.Now()
+	o.UpdatedAt = timestamp
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		var existing JobOutput
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("job_uuid = ? AND file_name = ?", o.JobUUID, o.FileName).First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			o.CreatedAt = timestamp
+			saved = true
+			return tx.Create(o).Error
+		}
+		if err != nil {
+			return err
+		}
+		if existing.Status != OutputQuarantined && existing.Status != OutputBlocked {
+			return nil
+		}
+		o.ID = existing.ID
+		o.CreatedAt = existing.CreatedAt
+		saved = true
+		return tx.Model(JobOutput{}).Where("id = ?", existing.ID).
+			Select("size", "sha256", "status", "report", "attested_sha256", "updated_at").Updates(o).Error
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to save job output ")
+	}
+	return saved, nil
+}
+
+// ReviewJobOutput records the review of an output still waiting for it together with its audit log,
+// it returns false if the output was already reviewed
+func ReviewJobOutput(o *JobOutput, log *AuditLog) (bool, error) {
+	reviewed := false
+	timestamp := time.Now()
+	log.CreatedAt = timestamp
+	log.UpdatedAt = timestamp
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(JobOutput{}).Where("id = ? AND status IN ?", o.ID, []string{OutputQuarantined, OutputBlocked}).
+			Updates(JobOutput{Status: o.Status, Reviewer: o.Reviewer, Comment: o.Comment, Sha256: o.Sha256, Size: o.Size})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		reviewed = true
+		return tx.Create(log).Error
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to update job output ")
+	}
+	return reviewed, nil
+}
+
+func QueryJobOutput(jobUUID string, fileName string) (*JobOutput, error) {
+	var res JobOutput
+	if err := DB.Model(JobOutput{}).Where("job_uuid = ? AND file_name = ?", jobUUID, fileName).First(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query job output ")
+	}
+	return &res, nil
+}
+
+func QueryJobOutputs(jobUUID string) ([]*JobOutput, error) {
+	var res []*JobOutput
+	if err := DB.Model(JobOutput{}).Where("job_uuid = ?", jobUUID).Order("file_name").Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query job outputs ")
+	}
+	return res, nil
+}
+
+// QueryJobOutputsToReview returns the outputs waiting for a reviewer, oldest first
+func QueryJobOutputsToReview(page, pageSize int64) ([]*JobOutput, int64, error) {
+	db := DB.Model(JobOutput{}).Where("status IN ?", []string{OutputQuarantined, OutputBlocked})
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count job outputs ")
+	}
+	var res []*JobOutput
+	if err := db.Order("id").Limit(int(pageSize)).Offset(int(pageSize * (page - 1))).Find(&res).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to query job outputs ")
+	}
+	return res, total, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// AttestationPolicy is a version of the attestation policy of a data provider, versions are never modified
+type AttestationPolicy struct {
+	gorm.Model
+	Provider string `gorm:"provider;uniqueIndex:idx_provider_version;size:32" json:"provider"`
+	Version  int64  `gorm:"version;uniqueIndex:idx_provider_version" json:"version"`
+	Document string `gorm:"document;type:text" json:"document"`
+}
+
+func (AttestationPolicy) TableName() string {
+	return "attestation_policies"
+}
+
+// CreatePolicy stores the policy as the next version of the provider's policy
+func CreatePolicy(p *AttestationPolicy) error {
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		var latest int64
+		if err := tx.Model(AttestationPolicy{}).Where("provider = ?", p.Provider).Select("COALESCE(MAX(version), 0)").Scan(&latest).Error; err != nil {
+			return err
+		}
+		timestamp := time.Now()
+		p.Version = latest + 1
+		p.CreatedAt = timestamp
+		p.UpdatedAt = timestamp
+		return tx.Create(p).Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to insert policy into policy table ")
+	}
+	return nil
+}
+
+// QueryPolicy returns the given version of the provider's policy, or the latest one if version is 0
+func QueryPolicy(provider string, version int64) (*AttestationPolicy, error) {
+	db := DB.Model(AttestationPolicy{}).Where("provider = ?", provider)
+	if version > 0 {
+		db = db.Where("version = ?", version)
+	}
+	var res AttestationPolicy
+	if err := db.Order("version DESC").First(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query policy ")
+	}
+	return &res, nil
+}
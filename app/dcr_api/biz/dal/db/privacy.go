@@ -0,0 +1,224 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+)
+
+const (
+	PrivacyReserved = "reserved"
+	PrivacyDebited  = "debited"
+	PrivacyReleased = "released"
+)
+
+// budgetTolerance absorbs the rounding of summed epsilons and deltas
+const budgetTolerance = 1e-9
+
+// PrivacyBudget overrides the default budget of a dataset for one analyst
+type PrivacyBudget struct {
+	gorm.Model
+	DatasetName string  `gorm:"dataset_name;uniqueIndex:idx_dataset_analyst;size:32" json:"dataset_name"`
+	Analyst     string  `gorm:"analyst;uniqueIndex:idx_dataset_analyst;size:32" json:"analyst"`
+	Epsilon     float64 `gorm:"epsilon" json:"epsilon"`
+	Delta       float64 `gorm:"delta" json:"delta"`
+}
+
+func (PrivacyBudget) TableName() string {
+	return "privacy_budgets"
+}
+
+// PrivacyLedgerEntry is the budget a job requested for one DP mechanism on a dataset. It's reserved at submission,
+// debited once the job completes with an attestation and released otherwise
+type PrivacyLedgerEntry struct {
+	gorm.Model
+	JobUUID     string  `gorm:"job_uuid;index" json:"job_uuid"`
+	DatasetName string  `gorm:"dataset_name;index:idx_ledger_dataset_analyst;size:32" json:"dataset_name"`
+	Analyst     string  `gorm:"analyst;index:idx_ledger_dataset_analyst;size:32" json:"analyst"`
+	Mechanism   string  `gorm:"mechanism" json:"mechanism"`
+	Epsilon     float64 `gorm:"epsilon" json:"epsilon"`
+	Delta       float64 `gorm:"delta" json:"delta"`
+	Status      string  `gorm:"status;index" json:"status"`
+}
+
+func (PrivacyLedgerEntry) TableName() string {
+	return "privacy_ledger"
+}
+
+// PrivacyUsage sums the ledger entries of an analyst on a dataset by status
+type PrivacyUsage struct {
+	Analyst string  `json:"analyst"`
+	Status  string  `json:"status"`
+	Epsilon float64 `json:"epsilon"`
+	Delta   float64 `json:"delta"`
+}
+
+// ReservePrivacyBudget reserves the requested budget of a job, it fails if any (dataset, analyst) pair would go over
+// its budget. The dataset rows are locked so concurrent submissions can't spend the same budget twice
+func ReservePrivacyBudget(entries []*PrivacyLedgerEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	timestamp := time.Now()
+	requested := map[string]*PrivacyUsage{}
+	order := []string{}
+	for _, e := range entries {
+		e.Status = PrivacyReserved
+		e.CreatedAt = timestamp
+		e.UpdatedAt = timestamp
+		if _, ok := requested[e.DatasetName]; !ok {
+			requested[e.DatasetName] = &PrivacyUsage{Analyst: e.Analyst}
+			order = append(order, e.DatasetName)
+		}
+		requested[e.DatasetName].Epsilon += e.Epsilon
+		requested[e.DatasetName].Delta += e.Delta
+	}
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		for _, name := range order {
+			r := requested[name]
+			var d Dataset
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("name = ?", name).First(&d).Error; err != nil {
+				return err
+			}
+			epsilon, delta := d.DpEpsilonBudget, d.DpDeltaBudget
+			var b PrivacyBudget
+			result := tx.Where("dataset_name = ? AND analyst = ?", name, r.Analyst).Limit(1).Find(&b)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				epsilon, delta = b.Epsilon, b.Delta
+			}
+			var used PrivacyUsage
+			if err := tx.Model(PrivacyLedgerEntry{}).
+				Where("dataset_name = ? AND analyst = ? AND status IN ?", name, r.Analyst, []string{PrivacyReserved, PrivacyDebited}).
+				Select("COALESCE(SUM(epsilon), 0) AS epsilon, COALESCE(SUM(delta), 0) AS delta").Scan(&used).Error; err != nil {
+				return err
+			}
+			if used.Epsilon+r.Epsilon > epsilon+budgetTolerance || used.Delta+r.Delta > delta+budgetTolerance {
+				return errno.PrivacyBudgetExceededErr.WithMessage(fmt.Sprintf(
+					"privacy budget of dataset %s is exceeded, requested epsilon %g delta %g, remaining epsilon %g delta %g",
+					name, r.Epsilon, r.Delta, max(epsilon-used.Epsilon, 0), max(delta-used.Delta, 0)))
+			}
+		}
+		return tx.Create(&entries).Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to reserve privacy budget ")
+	}
+	return nil
+}
+
+// SettlePrivacyBudget debits or releases the budget a job still holds in reserve
+func SettlePrivacyBudget(jobUUID string, status string) error {
+	err := DB.Model(PrivacyLedgerEntry{}).Where("job_uuid = ? AND status = ?", jobUUID, PrivacyReserved).
+		Updates(PrivacyLedgerEntry{Status: status}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to update privacy ledger ")
+	}
+	return nil
+}
+
+// SetPrivacyBudget creates or updates the budget of an analyst on a dataset
+func SetPrivacyBudget(b *PrivacyBudget) error {
+	timestamp := time.Now()
+	b.CreatedAt = timestamp
+	b.UpdatedAt = timestamp
+	err := DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "dataset_name"}, {Name: "analyst"}},
+		DoUpdates: clause.AssignmentColumns([]string{"epsilon", "delta", "updated_at"}),
+	}).Create(b).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to set privacy budget ")
+	}
+	return nil
+}
+
+// UpdateDatasetPrivacyBudget changes the default budget of each analyst on the dataset
+func UpdateDatasetPrivacyBudget(d *Dataset) error {
+	err := DB.Model(d).Select("dp_epsilon_budget", "dp_delta_budget").
+		Updates(Dataset{DpEpsilonBudget: d.DpEpsilonBudget, DpDeltaBudget: d.DpDeltaBudget}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to update dataset privacy budget ")
+	}
+	return nil
+}
+
+// QueryDatasetsPrivacyBudgets returns the budgets of the analysts on the datasets
+func QueryDatasetsPrivacyBudgets(datasetNames []string) ([]*PrivacyBudget, error) {
+	var res []*PrivacyBudget
+	if err := DB.Model(PrivacyBudget{}).Where("dataset_name IN ?", datasetNames).Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query privacy budgets ")
+	}
+	return res, nil
+}
+
+func QueryPrivacyBudgets(datasetName string) ([]*PrivacyBudget, error) {
+	var res []*PrivacyBudget
+	if err := DB.Model(PrivacyBudget{}).Where("dataset_name = ?", datasetName).Order("analyst").Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query privacy budgets ")
+	}
+	return res, nil
+}
+
+func QueryPrivacyUsage(datasetName string) ([]*PrivacyUsage, error) {
+	var res []*PrivacyUsage
+	err := DB.Model(PrivacyLedgerEntry{}).Where("dataset_name = ?", datasetName).
+		Select("analyst, status, SUM(epsilon) AS epsilon, SUM(delta) AS delta").
+		Group("analyst, status").Order("analyst").Scan(&res).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query privacy usage ")
+	}
+	return res, nil
+}
+
+func QueryPrivacyLedger(datasetName, analyst string, page, pageSize int64) ([]*PrivacyLedgerEntry, int64, error) {
+	db := DB.Model(PrivacyLedgerEntry{}).Where("dataset_name = ?", datasetName)
+	if len(analyst) != 0 {
+		db = db.Where("analyst = ?", analyst)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count privacy ledger ")
+	}
+	var res []*PrivacyLedgerEntry
+	if err := db.Order("id DESC").Limit(int(pageSize)).Offset(int(pageSize * (page - 1))).Find(&res).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to query privacy ledger ")
+	}
+	return res, total, nil
+}
+
+// QueryJobPrivacyLedger returns the ledger entries of each job
+func QueryJobPrivacyLedger(jobUUIDs []string) (map[string][]*PrivacyLedgerEntry, error) {
+	res := map[string][]*PrivacyLedgerEntry{}
+	if len(jobUUIDs) == 0 {
+		return res, nil
+	}
+	var records []*PrivacyLedgerEntry
+	if err := DB.Model(PrivacyLedgerEntry{}).Where("job_uuid IN ?", jobUUIDs).Order("id").Find(&records).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query privacy ledger ")
+	}
+	for _, r := range records {
+		res[r.JobUUID] = append(res[r.JobUUID], r)
+	}
+	return res, nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+)
+
+var initTestDB sync.Once
+
+// testDB connects to the MySQL database of the MYSQL_* environment variables, the tests are skipped without one
+func testDB(t *testing.T) {
+	t.Helper()
+	if os.Getenv("MYSQL_HOST") == "" {
+		t.Skip("MYSQL_HOST is not set, the ledger tests need a MySQL database")
+	}
+	initTestDB.Do(Init)
+}
+
+// newTestDataset creates a dataset with a name of its own, so the tests don't share budgets
+func newTestDataset(t *testing.T, epsilon float64, delta float64) string {
+	t.Helper()
+	name := fmt.Sprintf("ledger-%d", time.Now().UnixNano())
+	if err := DB.Create(&Dataset{Name: name, Provider: "provider", DpEpsilonBudget: epsilon, DpDeltaBudget: delta}).Error; err != nil {
+		t.Fatalf("failed to create dataset: %v", err)
+	}
+	return name
+}
+
+func ledgerEntry(jobUUID string, dataset string, analyst string, epsilon float64, delta float64) *PrivacyLedgerEntry {
+	mechanism := "laplace"
+	if delta > 0 {
+		mechanism = "gaussian"
+	}
+	return &PrivacyLedgerEntry{JobUUID: jobUUID, DatasetName: dataset, Analyst: analyst, Mechanism: mechanism, Epsilon: epsilon, Delta: delta}
+}
+
+func reserve(entries ...*PrivacyLedgerEntry) error {
+	return ReservePrivacyBudget(entries)
+}
+
+func requireExceeded(t *testing.T, err error) {
+	t.Helper()
+	if !errors.Is(err, errno.PrivacyBudgetExceededErr) {
+		t.Fatalf("got error %v, want the budget to be exceeded", err)
+	}
+}
+
+func TestReservePrivacyBudget(t *testing.T) {
+	testDB(t)
+	dataset := newTestDataset(t, 1, 1e-5)
+	// the mechanisms of a job add up
+	err := reserve(ledgerEntry(dataset+"-job-1", dataset, "alice", 0.4, 0), ledgerEntry(dataset+"-job-1", dataset, "alice", 0.3, 5e-6))
+	if err != nil {
+		t.Fatalf("failed to reserve budget: %v", err)
+	}
+	entries, err := QueryJobPrivacyLedger([]string{dataset + "-job-1"})
+	if err != nil {
+		t.Fatalf("failed to query ledger: %v", err)
+	}
+	for _, e := range entries[dataset+"-job-1"] {
+		if e.DatasetName == dataset && e.Status != PrivacyReserved {
+			t.Fatalf("entry %+v is not reserved", e)
+		}
+	}
+	requireExceeded(t, reserve(ledgerEntry(dataset+"-job-2", dataset, "alice", 0.4, 0)))
+	requireExceeded(t, reserve(ledgerEntry(dataset+"-job-2", dataset, "alice", 0.1, 6e-6)))
+	// a rejected job reserves nothing, and the budget of each analyst is its own
+	if err = reserve(ledgerEntry(dataset+"-job-2", dataset, "alice", 0.3, 0)); err != nil {
+		t.Fatalf("failed to reserve the remaining budget: %v", err)
+	}
+	if err = reserve(ledgerEntry(dataset+"-job-3", dataset, "bob", 1, 0)); err != nil {
+		t.Fatalf("failed to reserve the budget of another analyst: %v", err)
+	}
+}
+
+func TestSettlePrivacyBudget(t *testing.T) {
+	testDB(t)
+	dataset := newTestDataset(t, 1, 0)
+	if err := reserve(ledgerEntry(dataset+"-job-1", dataset, "alice", 0.6, 0)); err != nil {
+		t.Fatalf("failed to reserve budget: %v", err)
+	}
+	// a released budget can be reserved again
+	if err := SettlePrivacyBudget(dataset+"-job-1", PrivacyReleased); err != nil {
+		t.Fatalf("failed to release budget: %v", err)
+	}
+	if err := reserve(ledgerEntry(dataset+"-job-2", dataset, "alice", 0.6, 0)); err != nil {
+		t.Fatalf("failed to reserve released budget: %v", err)
+	}
+	// a debited budget is spent for good, settling again doesn't change it
+	if err := SettlePrivacyBudget(dataset+"-job-2", PrivacyDebited); err != nil {
+		t.Fatalf("failed to debit budget: %v", err)
+	}
+	if err := SettlePrivacyBudget(dataset+"-job-2", PrivacyReleased); err != nil {
+		t.Fatalf("failed to settle budget: %v", err)
+	}
+	requireExceeded(t, reserve(ledgerEntry(dataset+"-job-3", dataset, "alice", 0.6, 0)))
+	usage, err := QueryPrivacyUsage(dataset)
+	if err != nil {
+		t.Fatalf("failed to query usage: %v", err)
+	}
+	spent := 0.0
+	for _, u := range usage {
+		if u.Status == PrivacyDebited {
+			spent += u.Epsilon
+		}
+	}
+	if spent != 0.6 {
+		t.Fatalf("spent epsilon %g, want 0.6", spent)
+	}
+}
+
+func TestReservePrivacyBudgetOverride(t *testing.T) {
+	testDB(t)
+	dataset := newTestDataset(t, 0, 0)
+	if err := SetPrivacyBudget(&PrivacyBudget{DatasetName: dataset, Analyst: "bob", Epsilon: 2}); err != nil {
+		t.Fatalf("failed to set budget: %v", err)
+	}
+	if err := reserve(ledgerEntry(dataset+"-job-1", dataset, "bob", 1.5, 0)); err != nil {
+		t.Fatalf("failed to reserve overridden budget: %v", err)
+	}
+	requireExceeded(t, reserve(ledgerEntry(dataset+"-job-2", dataset, "bob", 1, 0)))
+	requireExceeded(t, reserve(ledgerEntry(dataset+"-job-3", dataset, "alice", 0.1, 0)))
+}
+
+func TestConcurrentPrivacyReservations(t *testing.T) {
+	testDB(t)
+	dataset := newTestDataset(t, 1, 0)
+	const jobs = 2
+	start := make(chan struct{})
+	errs := make(chan error, jobs)
+	var wg sync.WaitGroup
+	for i := 0; i < jobs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs <- reserve(ledgerEntry(fmt.Sprintf("%s-job-%d", dataset, i), dataset, "alice", 0.6, 0))
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+	reserved := 0
+	for err := range errs {
+		if err == nil {
+			reserved++
+			continue
+		}
+		requireExceeded(t, err)
+	}
+	if reserved != 1 {
+		t.Fatalf("%d of the concurrent jobs reserved the budget, want 1", reserved)
+	}
+	// the job that got the budget debits it, the other one still can't
+	if err := SettlePrivacyBudget(fmt.Sprintf("%s-job-0", dataset), PrivacyDebited); err != nil {
+		t.Fatalf("failed to debit budget: %v", err)
+	}
+	if err := SettlePrivacyBudget(fmt.Sprintf("%s-job-1", dataset), PrivacyDebited); err != nil {
+		t.Fatalf("failed to debit budget: %v", err)
+	}
+	requireExceeded(t, reserve(ledgerEntry(dataset+"-job-3", dataset, "alice", 0.5, 0)))
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by hertz generator.
+
+package approval
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/approval"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/service"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+)
+
+// QueryApproval .
+// @router /v1/approval/query/ [POST]
+func QueryApproval(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req approval.QueryApprovalRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Approval Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	approvals, total, err := service.NewApprovalService(ctx).QueryApprovals(&req)
+	if err != nil {
+		hlog.Errorf("[Approval Handler]failed to query approvals: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, approval.QueryApprovalResponse{
+		Code:      errno.SuccessCode,
+		Msg:       errno.SuccessMsg,
+		Approvals: approvals,
+		Total:     total,
+	})
+}
+
+// ReviewJob .
+// @router /v1/approval/review/ [POST]
+func ReviewJob(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req approval.ReviewJobRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Approval Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	resp, err := service.NewApprovalService(ctx).ReviewJob(&req)
+	if err != nil {
+		hlog.Errorf("[Approval Handler]failed to review job: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	resp.Code = errno.SuccessCode
+	resp.Msg = errno.SuccessMsg
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DecideJob .
+// @router /v1/approval/decide/ [POST]
+func DecideJob(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req approval.DecideJobRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Approval Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	err = service.NewApprovalService(ctx).DecideJob(&req)
+	if err != nil {
+		hlog.Errorf("[Approval Handler]failed to decide job: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, approval.DecideJobResponse{
+		Code: errno.SuccessCode,
+		Msg:  errno.SuccessMsg,
+	})
+}
+
+// QueryAuditLog .
+// @router /v1/approval/audit/ [POST]
+func QueryAuditLog(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req approval.QueryAuditLogRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Approval Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	logs, err := service.NewApprovalService(ctx).QueryAuditLogs(&req)
+	if err != nil {
+		hlog.Errorf("[Approval Handler]failed to query audit logs: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, approval.QueryAuditLogResponse{
+		Code: errno.SuccessCode,
+		Msg:  errno.SuccessMsg,
+		Logs: logs,
+	})
+}
@@ -0,0 +1,216 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by hertz generator.
+
+package dataset
+
+import (
+	"context"
+	"mime/multipart"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/dataset"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/service"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+)
+
+type PreviewFileParas struct {
+	FileHeader  *multipart.FileHeader `form:"file"`
+	Name        string                `form:"name"`
+	Provider    string                `form:"provider"`
+	Kind        string                `form:"kind"`
+	Description string                `form:"description"`
+	Filename    string                `form:"filename"`
+	AccessToken string                `header:"Authorization,required"`
+}
+
+// RegisterDataset .
+// @router /v1/dataset/register/ [POST]
+func RegisterDataset(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req dataset.RegisterDatasetRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	d, err := service.NewDatasetService(ctx).RegisterDataset(&req)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to register dataset: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, dataset.RegisterDatasetResponse{
+		Code:    errno.SuccessCode,
+		Msg:     errno.SuccessMsg,
+		Dataset: d,
+	})
+}
+
+// QueryDataset .
+// @router /v1/dataset/query/ [POST]
+func QueryDataset(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req dataset.QueryDatasetRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	datasets, total, err := service.NewDatasetService(ctx).QueryDatasets(&req)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to query datasets: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, dataset.QueryDatasetResponse{
+		Code:     errno.SuccessCode,
+		Msg:      errno.SuccessMsg,
+		Datasets: datasets,
+		Total:    total,
+	})
+}
+
+// DescribeDataset .
+// @router /v1/dataset/describe/ [POST]
+func DescribeDataset(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req dataset.DescribeDatasetRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	d, err := service.NewDatasetService(ctx).DescribeDataset(&req)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to describe dataset: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, dataset.DescribeDatasetResponse{
+		Code:    errno.SuccessCode,
+		Msg:     errno.SuccessMsg,
+		Dataset: d,
+	})
+}
+
+// UploadDatasetPreview .
+// @router /v1/dataset/preview/upload/ [POST]
+func UploadDatasetPreview(ctx context.Context, c *app.RequestContext) {
+	var req dataset.UploadDatasetPreviewRequest
+	var formReq PreviewFileParas
+	err := c.BindAndValidate(&formReq)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+
+	req.Name = formReq.Name
+	req.Provider = formReq.Provider
+	req.Kind = formReq.Kind
+	req.Description = formReq.Description
+	req.Filename = formReq.Filename
+	req.AccessToken = formReq.AccessToken
+	if req.Filename == "" && formReq.FileHeader != nil {
+		req.Filename = formReq.FileHeader.Filename
+	}
+	// the form is bound into the IDL request, so it's validated with the IDL rules
+	err = c.Validate(&req)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to validate parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	if formReq.FileHeader == nil {
+		hlog.Errorf("[Dataset Handler]preview file is missing")
+		utils.ReturnsJSONError(c, errno.ServiceErr.WithMessage("preview file is missing"))
+		return
+	}
+	file, err := formReq.FileHeader.Open()
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to open file %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	defer file.Close()
+
+	preview, err := service.NewDatasetService(ctx).UploadDatasetPreview(&req, file)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to upload dataset preview: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, dataset.UploadDatasetPreviewResponse{
+		Code:    errno.SuccessCode,
+		Msg:     errno.SuccessMsg,
+		Preview: preview,
+	})
+}
+
+// QueryDatasetPreview .
+// @router /v1/dataset/preview/query/ [POST]
+func QueryDatasetPreview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req dataset.QueryDatasetPreviewRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	previews, err := service.NewDatasetService(ctx).QueryDatasetPreviews(&req)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to query dataset previews: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, dataset.QueryDatasetPreviewResponse{
+		Code:     errno.SuccessCode,
+		Msg:      errno.SuccessMsg,
+		Previews: previews,
+	})
+}
+
+// DownloadDatasetPreview .
+// @router /v1/dataset/preview/download/ [POST]
+func DownloadDatasetPreview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req dataset.DownloadDatasetPreviewRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	content, err := service.NewDatasetService(ctx).DownloadDatasetPreview(&req)
+	if err != nil {
+		hlog.Errorf("[Dataset Handler]failed to download dataset preview: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, dataset.DownloadDatasetPreviewResponse{
+		Code:    errno.SuccessCode,
+		Msg:     errno.SuccessMsg,
+		Content: content,
+	})
+}
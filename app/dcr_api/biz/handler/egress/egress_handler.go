@@ -0,0 +1,162 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by hertz generator.
+
+package egress
+
+import (
+	"context"
+	"mime/multipart"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/egress"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/service"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+)
+
+type RedactFileParas struct {
+	FileHeader  *multipart.FileHeader `form:"file"`
+	UUID        string                `form:"uuid"`
+	Filename    string                `form:"filename"`
+	Reviewer    string                `form:"reviewer"`
+	Comment     string                `form:"comment"`
+	AccessToken string                `header:"Authorization,required"`
+}
+
+// QueryJobOutputs .
+// @router /v1/egress/query/ [POST]
+func QueryJobOutputs(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req egress.QueryJobOutputsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Egress Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	outputs, total, err := service.NewEgressService(ctx).QueryJobOutputs(&req)
+	if err != nil {
+		hlog.Errorf("[Egress Handler]failed to query job outputs: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, egress.QueryJobOutputsResponse{
+		Code:    errno.SuccessCode,
+		Msg:     errno.SuccessMsg,
+		Outputs: outputs,
+		Total:   total,
+	})
+}
+
+// DownloadQuarantinedOutput .
+// @router /v1/egress/download/ [POST]
+func DownloadQuarantinedOutput(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req egress.DownloadQuarantinedOutputRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Egress Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	content, err := service.NewEgressService(ctx).DownloadQuarantinedOutput(&req)
+	if err != nil {
+		hlog.Errorf("[Egress Handler]failed to download quarantined output: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, egress.DownloadQuarantinedOutputResponse{
+		Code:    errno.SuccessCode,
+		Msg:     errno.SuccessMsg,
+		Content: content,
+	})
+}
+
+// DecideJobOutput .
+// @router /v1/egress/decide/ [POST]
+func DecideJobOutput(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req egress.DecideJobOutputRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Egress Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	err = service.NewEgressService(ctx).DecideJobOutput(&req)
+	if err != nil {
+		hlog.Errorf("[Egress Handler]failed to decide job output: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, egress.DecideJobOutputResponse{
+		Code: errno.SuccessCode,
+		Msg:  errno.SuccessMsg,
+	})
+}
+
+// RedactJobOutput .
+// @router /v1/egress/redact/ [POST]
+func RedactJobOutput(ctx context.Context, c *app.RequestContext) {
+	var req egress.RedactJobOutputRequest
+	var formReq RedactFileParas
+	err := c.BindAndValidate(&formReq)
+	if err != nil {
+		hlog.Errorf("[Egress Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+
+	req.UUID = formReq.UUID
+	req.Filename = formReq.Filename
+	req.Reviewer = formReq.Reviewer
+	req.Comment = formReq.Comment
+	req.AccessToken = formReq.AccessToken
+	// the form is bound into the IDL request, so it's validated with the IDL rules
+	err = c.Validate(&req)
+	if err != nil {
+		hlog.Errorf("[Egress Handler]failed to validate parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	if formReq.FileHeader == nil {
+		hlog.Errorf("[Egress Handler]redacted file is missing")
+		utils.ReturnsJSONError(c, errno.ServiceErr.WithMessage("redacted file is missing"))
+		return
+	}
+	file, err := formReq.FileHeader.Open()
+	if err != nil {
+		hlog.Errorf("[Egress Handler]failed to open file %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	defer file.Close()
+
+	output, err := service.NewEgressService(ctx).RedactJobOutput(&req, file)
+	if err != nil {
+		hlog.Errorf("[Egress Handler]failed to redact job output: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, egress.RedactJobOutputResponse{
+		Code:   errno.SuccessCode,
+		Msg:    errno.SuccessMsg,
+		Output: output,
+	})
+}
@@ -33,6 +33,13 @@ type FileParas struct {
 	FileHeader      *multipart.FileHeader `form:"file"`
 	Creator         string                `form:"creator"`
 	JupyterFileName string                `form:"filename"`
+	PolicyProvider  string                `form:"provider"`
+	Datasets        []string              `form:"datasets"`
+	PrivacyBudgets  []string              `form:"privacy_budgets"`
+	TEEType         string                `form:"tee_type"`
+	Profile         string                `form:"profile"`
+	Spot            bool                  `form:"spot"`
+	NetworkMode     string                `form:"network_mode"`
 	AccessToken     string                `header:"Authorization,required"`
 }
 
@@ -51,6 +58,13 @@ func SubmitJob(ctx context.Context, c *app.RequestContext) {
 	req.JupyterFileName = formReq.JupyterFileName
 	req.AccessToken = formReq.AccessToken
 	req.Creator = formReq.Creator
+	req.PolicyProvider = formReq.PolicyProvider
+	req.Datasets = formReq.Datasets
+	req.PrivacyBudgets = formReq.PrivacyBudgets
+	req.TeeType = formReq.TEEType
+	req.Profile = formReq.Profile
+	req.Spot = formReq.Spot
+	req.NetworkMode = formReq.NetworkMode
 	file, err := formReq.FileHeader.Open()
 	if err != nil {
 		hlog.Errorf("[Job Handler]failed to open file %+v", err)
@@ -115,6 +129,29 @@ func DeleteJob(ctx context.Context, c *app.RequestContext) {
 	})
 }
 
+// CancelJob .
+// @router /v1/job/cancel/ [POST]
+func CancelJob(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.CancelJobRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	err = service.NewJobService(ctx).CancelJob(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to cancel job: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, job.CancelJobResponse{
+		Code: errno.SuccessCode,
+		Msg:  errno.SuccessMsg,
+	})
+}
+
 // UpdateJobStatus .
 // @router /v1/job/update/ [POST]
 func UpdateJobStatus(ctx context.Context, c *app.RequestContext) {
@@ -189,6 +226,53 @@ func DownloadJobOutput(ctx context.Context, c *app.RequestContext) {
 	})
 }
 
+// ListJobOutputs .
+// @router /v1/job/output/list/ [POST]
+func ListJobOutputs(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.ListJobOutputsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	outputs, root, err := service.NewJobService(ctx).ListJobOutputs(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to list job outputs: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, job.ListJobOutputsResponse{
+		Code:         errno.SuccessCode,
+		Msg:          errno.SuccessMsg,
+		Outputs:      outputs,
+		ManifestRoot: root,
+	})
+}
+
+// VerifyJobOutput .
+// @router /v1/job/output/verify/ [POST]
+func VerifyJobOutput(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.VerifyJobOutputRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	resp, err := service.NewJobService(ctx).VerifyJobOutput(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to verify job output: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	resp.Code = errno.SuccessCode
+	resp.Msg = errno.SuccessMsg
+	c.JSON(consts.StatusOK, resp)
+}
+
 // QueryJobAttestationReport .
 // @router /v1/job/attestation/ [POST]
 func QueryJobAttestationReport(ctx context.Context, c *app.RequestContext) {
@@ -212,3 +296,96 @@ func QueryJobAttestationReport(ctx context.Context, c *app.RequestContext) {
 		Token: report,
 	})
 }
+
+// VerifyJobAttestation .
+// @router /v1/job/attestation/verify/ [POST]
+func VerifyJobAttestation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.VerifyJobAttestationRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	resp, err := service.NewJobService(ctx).VerifyJobAttestation(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to verify job attestation: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	resp.Code = errno.SuccessCode
+	resp.Msg = errno.SuccessMsg
+	c.JSON(consts.StatusOK, resp)
+}
+
+// QueryJobProvenance .
+// @router /v1/job/provenance/ [POST]
+func QueryJobProvenance(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.QueryJobProvenanceRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	sbom, provenance, err := service.NewProvenanceService(ctx).GetJobProvenance(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to query job provenance: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, job.QueryJobProvenanceResponse{
+		Code:       errno.SuccessCode,
+		Msg:        errno.SuccessMsg,
+		Sbom:       sbom,
+		Provenance: provenance,
+	})
+}
+
+// QueryJobAttempts .
+// @router /v1/job/attempts/ [POST]
+func QueryJobAttempts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.QueryJobAttemptsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	attempts, err := service.NewJobService(ctx).QueryJobAttempts(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to query job attempts: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, job.QueryJobAttemptsResponse{
+		Code:     errno.SuccessCode,
+		Msg:      errno.SuccessMsg,
+		Attempts: attempts,
+	})
+}
+
+// QueryJobState .
+// @router /v1/job/state/ [POST]
+func QueryJobState(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.QueryJobStateRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	res, err := service.NewJobService(ctx).QueryJobState(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to query job state: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	res.Code = errno.SuccessCode
+	res.Msg = errno.SuccessMsg
+	c.JSON(consts.StatusOK, res)
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by hertz generator.
+
+package policy
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/policy"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/service"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+)
+
+// CreatePolicy .
+// @router /v1/policy/create/ [POST]
+func CreatePolicy(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req policy.CreatePolicyRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Policy Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	version, err := service.NewPolicyService(ctx).CreatePolicy(&req)
+	if err != nil {
+		hlog.Errorf("[Policy Handler]failed to create policy: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, policy.CreatePolicyResponse{
+		Code:    errno.SuccessCode,
+		Msg:     errno.SuccessMsg,
+		Version: version,
+	})
+}
+
+// QueryPolicy .
+// @router /v1/policy/query/ [POST]
+func QueryPolicy(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req policy.QueryPolicyRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Policy Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	p, err := service.NewPolicyService(ctx).QueryPolicy(&req)
+	if err != nil {
+		hlog.Errorf("[Policy Handler]failed to query policy: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, policy.QueryPolicyResponse{
+		Code:   errno.SuccessCode,
+		Msg:    errno.SuccessMsg,
+		Policy: p,
+	})
+}
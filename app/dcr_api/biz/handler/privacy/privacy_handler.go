@@ -0,0 +1,102 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by hertz generator.
+
+package privacy
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/privacy"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/service"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+)
+
+// SetPrivacyBudget .
+// @router /v1/privacy/budget/set/ [POST]
+func SetPrivacyBudget(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req privacy.SetPrivacyBudgetRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Privacy Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	err = service.NewPrivacyService(ctx).SetPrivacyBudget(&req)
+	if err != nil {
+		hlog.Errorf("[Privacy Handler]failed to set privacy budget: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, privacy.SetPrivacyBudgetResponse{
+		Code: errno.SuccessCode,
+		Msg:  errno.SuccessMsg,
+	})
+}
+
+// QueryPrivacyBudget .
+// @router /v1/privacy/budget/query/ [POST]
+func QueryPrivacyBudget(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req privacy.QueryPrivacyBudgetRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Privacy Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	budgets, err := service.NewPrivacyService(ctx).QueryPrivacyBudgets(&req)
+	if err != nil {
+		hlog.Errorf("[Privacy Handler]failed to query privacy budgets: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, privacy.QueryPrivacyBudgetResponse{
+		Code:    errno.SuccessCode,
+		Msg:     errno.SuccessMsg,
+		Budgets: budgets,
+	})
+}
+
+// QueryPrivacyLedger .
+// @router /v1/privacy/ledger/ [POST]
+func QueryPrivacyLedger(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req privacy.QueryPrivacyLedgerRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Privacy Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	entries, total, err := service.NewPrivacyService(ctx).QueryPrivacyLedger(&req)
+	if err != nil {
+		hlog.Errorf("[Privacy Handler]failed to query privacy ledger: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, privacy.QueryPrivacyLedgerResponse{
+		Code:    errno.SuccessCode,
+		Msg:     errno.SuccessMsg,
+		Entries: entries,
+		Total:   total,
+	})
+}
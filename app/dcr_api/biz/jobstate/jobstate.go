@@ -0,0 +1,87 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jobstate is the state machine of the jobs. A job is queued when it's submitted, builds its image,
+// waits for its VM, possibly for the approvals of the data providers first, and runs until it ends. A running
+// spot job is preempted and runs again, any job in progress can be cancelled or time out
+package jobstate
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+)
+
+// transitions are the statuses a job can move to from each status, the ended statuses have none
+var transitions = map[job.JobStatus][]job.JobStatus{
+	job.JobStatus_Queued: {
+		job.JobStatus_ImageBuilding, job.JobStatus_ImageBuildingFailed, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+	job.JobStatus_ImageBuilding: {
+		job.JobStatus_VMWaiting, job.JobStatus_ImageBuildingFailed, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+	job.JobStatus_VMWaiting: {
+		job.JobStatus_PendingApproval, job.JobStatus_VMRunning, job.JobStatus_VMFailed, job.JobStatus_Cancelled,
+		job.JobStatus_TimedOut,
+	},
+	job.JobStatus_PendingApproval: {
+		job.JobStatus_VMWaiting, job.JobStatus_ApprovalRejected, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+	job.JobStatus_VMRunning: {
+		job.JobStatus_VMPreempted, job.JobStatus_VMFinished, job.JobStatus_VMFailed, job.JobStatus_VMKilled,
+		job.JobStatus_VMOther, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+	job.JobStatus_VMPreempted: {
+		job.JobStatus_VMRunning, job.JobStatus_VMFailed, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+	job.JobStatus_VMOther: {
+		job.JobStatus_VMRunning, job.JobStatus_VMFinished, job.JobStatus_VMFailed, job.JobStatus_VMKilled,
+		job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+}
+
+// CanTransition reports whether a job can move from a status to another
+func CanTransition(from job.JobStatus, to job.JobStatus) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Check returns an IllegalJobTransitionErr if the job can't move from a status to another
+func Check(uuid string, from job.JobStatus, to job.JobStatus) error {
+	if CanTransition(from, to) {
+		return nil
+	}
+	return errno.IllegalJobTransitionErr.WithMessage(fmt.Sprintf("job %s can't move from %s to %s", uuid, from, to))
+}
+
+// IsEnded reports whether the job can't move anymore
+func IsEnded(status job.JobStatus) bool {
+	return len(transitions[status]) == 0
+}
+
+// InProgress returns the statuses a job is in progress in, those it can still move from
+func InProgress() []job.JobStatus {
+	statuses := make([]job.JobStatus, 0, len(transitions))
+	for status := range transitions {
+		statuses = append(statuses, status)
+	}
+	slices.Sort(statuses)
+	return statuses
+}
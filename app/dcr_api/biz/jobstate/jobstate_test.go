@@ -0,0 +1,115 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobstate
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+)
+
+// allStatuses are all the statuses of the IDL
+var allStatuses = []job.JobStatus{
+	job.JobStatus_ImageBuilding, job.JobStatus_ImageBuildingFailed, job.JobStatus_VMWaiting,
+	job.JobStatus_VMRunning, job.JobStatus_VMFinished, job.JobStatus_VMKilled, job.JobStatus_VMFailed,
+	job.JobStatus_VMOther, job.JobStatus_PendingApproval, job.JobStatus_ApprovalRejected,
+	job.JobStatus_VMPreempted, job.JobStatus_Queued, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+}
+
+// allowed are the transitions of the lifecycle, written out apart from the state machine
+var allowed = map[job.JobStatus][]job.JobStatus{
+	job.JobStatus_Queued: {
+		job.JobStatus_ImageBuilding, job.JobStatus_ImageBuildingFailed, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+	job.JobStatus_ImageBuilding: {
+		job.JobStatus_VMWaiting, job.JobStatus_ImageBuildingFailed, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+	job.JobStatus_VMWaiting: {
+		job.JobStatus_PendingApproval, job.JobStatus_VMRunning, job.JobStatus_VMFailed, job.JobStatus_Cancelled,
+		job.JobStatus_TimedOut,
+	},
+	job.JobStatus_PendingApproval: {
+		job.JobStatus_VMWaiting, job.JobStatus_ApprovalRejected, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+	job.JobStatus_VMRunning: {
+		job.JobStatus_VMPreempted, job.JobStatus_VMFinished, job.JobStatus_VMFailed, job.JobStatus_VMKilled,
+		job.JobStatus_VMOther, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+	job.JobStatus_VMPreempted: {
+		job.JobStatus_VMRunning, job.JobStatus_VMFailed, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+	job.JobStatus_VMOther: {
+		job.JobStatus_VMRunning, job.JobStatus_VMFinished, job.JobStatus_VMFailed, job.JobStatus_VMKilled,
+		job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+	},
+}
+
+var ended = []job.JobStatus{
+	job.JobStatus_ImageBuildingFailed, job.JobStatus_VMFinished, job.JobStatus_VMKilled, job.JobStatus_VMFailed,
+	job.JobStatus_ApprovalRejected, job.JobStatus_Cancelled, job.JobStatus_TimedOut,
+}
+
+func TestCanTransition(t *testing.T) {
+	for _, from := range allStatuses {
+		for _, to := range allStatuses {
+			want := slices.Contains(allowed[from], to)
+			if got := CanTransition(from, to); got != want {
+				t.Errorf("CanTransition(%s, %s) = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	for _, from := range allStatuses {
+		for _, to := range allStatuses {
+			err := Check("job-uuid", from, to)
+			if slices.Contains(allowed[from], to) {
+				if err != nil {
+					t.Errorf("Check(%s, %s) = %v, want nil", from, to, err)
+				}
+				continue
+			}
+			if !errors.Is(err, errno.IllegalJobTransitionErr) {
+				t.Errorf("Check(%s, %s) = %v, want IllegalJobTransitionErr", from, to, err)
+			}
+		}
+	}
+}
+
+func TestIsEnded(t *testing.T) {
+	for _, status := range allStatuses {
+		want := slices.Contains(ended, status)
+		if got := IsEnded(status); got != want {
+			t.Errorf("IsEnded(%s) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestInProgress(t *testing.T) {
+	var want []job.JobStatus
+	for _, status := range allStatuses {
+		if !slices.Contains(ended, status) {
+			want = append(want, status)
+		}
+	}
+	slices.Sort(want)
+	if got := InProgress(); !slices.Equal(got, want) {
+		t.Errorf("InProgress() = %v, want %v", got, want)
+	}
+}
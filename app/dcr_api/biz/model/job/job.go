@@ -21,6 +21,12 @@ const (
 	JobStatus_VMKilled            JobStatus = 6
 	JobStatus_VMFailed            JobStatus = 7
 	JobStatus_VMOther             JobStatus = 8
+	JobStatus_PendingApproval     JobStatus = 9
+	JobStatus_ApprovalRejected    JobStatus = 10
+	JobStatus_VMPreempted         JobStatus = 11
+	JobStatus_Queued              JobStatus = 12
+	JobStatus_Cancelled           JobStatus = 13
+	JobStatus_TimedOut            JobStatus = 14
 )
 
 func (p JobStatus) String() string {
@@ -41,6 +47,18 @@ func (p JobStatus) String() string {
 		return "VMFailed"
 	case JobStatus_VMOther:
 		return "VMOther"
+	case JobStatus_PendingApproval:
+		return "PendingApproval"
+	case JobStatus_ApprovalRejected:
+		return "ApprovalRejected"
+	case JobStatus_VMPreempted:
+		return "VMPreempted"
+	case JobStatus_Queued:
+		return "Queued"
+	case JobStatus_Cancelled:
+		return "Cancelled"
+	case JobStatus_TimedOut:
+		return "TimedOut"
 	}
 	return "<UNSET>"
 }
@@ -63,6 +81,18 @@ func JobStatusFromString(s string) (JobStatus, error) {
 		return JobStatus_VMFailed, nil
 	case "VMOther":
 		return JobStatus_VMOther, nil
+	case "PendingApproval":
+		return JobStatus_PendingApproval, nil
+	case "ApprovalRejected":
+		return JobStatus_ApprovalRejected, nil
+	case "VMPreempted":
+		return JobStatus_VMPreempted, nil
+	case "Queued":
+		return JobStatus_Queued, nil
+	case "Cancelled":
+		return JobStatus_Cancelled, nil
+	case "TimedOut":
+		return JobStatus_TimedOut, nil
 	}
 	return JobStatus(0), fmt.Errorf("not a valid JobStatus string")
 }
@@ -90,6 +120,17 @@ type Job struct {
 	JupyterFileName string    `thrift:"jupyter_file_name,5" form:"jupyter_file_name" json:"jupyter_file_name" query:"jupyter_file_name"`
 	CreatedAt       string    `thrift:"created_at,6" form:"created_at" json:"created_at" query:"created_at"`
 	UpdatedAt       string    `thrift:"updated_at,7" form:"updated_at" json:"updated_at" query:"updated_at"`
+	FailureReason   string    `thrift:"failure_reason,8" form:"failure_reason" json:"failure_reason" query:"failure_reason"`
+	PolicyProvider  string    `thrift:"policy_provider,9" form:"policy_provider" json:"policy_provider" query:"policy_provider"`
+	PolicyVersion   int64     `thrift:"policy_version,10" form:"policy_version" json:"policy_version" query:"policy_version"`
+	Datasets        []string  `thrift:"datasets,11" form:"datasets" json:"datasets" query:"datasets"`
+	PrivacyBudgets  []string  `thrift:"privacy_budgets,12" form:"privacy_budgets" json:"privacy_budgets" query:"privacy_budgets"`
+	TeeType         string    `thrift:"tee_type,13" form:"tee_type" json:"tee_type" query:"tee_type"`
+	Profile         string    `thrift:"profile,14" form:"profile" json:"profile" query:"profile"`
+	Spot            bool      `thrift:"spot,15" form:"spot" json:"spot" query:"spot"`
+	Attempts        int32     `thrift:"attempts,16" form:"attempts" json:"attempts" query:"attempts"`
+	Zone            string    `thrift:"zone,17" form:"zone" json:"zone" query:"zone"`
+	NetworkMode     string    `thrift:"network_mode,18" form:"network_mode" json:"network_mode" query:"network_mode"`
 }
 
 func NewJob() *Job {
@@ -124,14 +165,69 @@ func (p *Job) GetUpdatedAt() (v string) {
 	return p.UpdatedAt
 }
 
+func (p *Job) GetFailureReason() (v string) {
+	return p.FailureReason
+}
+
+func (p *Job) GetPolicyProvider() (v string) {
+	return p.PolicyProvider
+}
+
+func (p *Job) GetPolicyVersion() (v int64) {
+	return p.PolicyVersion
+}
+
+func (p *Job) GetDatasets() (v []string) {
+	return p.Datasets
+}
+
+func (p *Job) GetPrivacyBudgets() (v []string) {
+	return p.PrivacyBudgets
+}
+
+func (p *Job) GetTeeType() (v string) {
+	return p.TeeType
+}
+
+func (p *Job) GetProfile() (v string) {
+	return p.Profile
+}
+
+func (p *Job) GetSpot() (v bool) {
+	return p.Spot
+}
+
+func (p *Job) GetAttempts() (v int32) {
+	return p.Attempts
+}
+
+func (p *Job) GetZone() (v string) {
+	return p.Zone
+}
+
+func (p *Job) GetNetworkMode() (v string) {
+	return p.NetworkMode
+}
+
 var fieldIDToName_Job = map[int16]string{
-	1: "id",
-	2: "uuid",
-	3: "creator",
-	4: "job_status",
-	5: "jupyter_file_name",
-	6: "created_at",
-	7: "updated_at",
+	1:  "id",
+	2:  "uuid",
+	3:  "creator",
+	4:  "job_status",
+	5:  "jupyter_file_name",
+	6:  "created_at",
+	7:  "updated_at",
+	8:  "failure_reason",
+	9:  "policy_provider",
+	10: "policy_version",
+	11: "datasets",
+	12: "privacy_budgets",
+	13: "tee_type",
+	14: "profile",
+	15: "spot",
+	16: "attempts",
+	17: "zone",
+	18: "network_mode",
 }
 
 func (p *Job) Read(iprot thrift.TProtocol) (err error) {
@@ -209,6 +305,94 @@ func (p *Job) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 16:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField16(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 17:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField17(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 18:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField18(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -315,6 +499,151 @@ func (p *Job) ReadField7(iprot thrift.TProtocol) error {
 	p.UpdatedAt = _field
 	return nil
 }
+func (p *Job) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FailureReason = _field
+	return nil
+}
+func (p *Job) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PolicyProvider = _field
+	return nil
+}
+func (p *Job) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PolicyVersion = _field
+	return nil
+}
+func (p *Job) ReadField11(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Datasets = _field
+	return nil
+}
+func (p *Job) ReadField12(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.PrivacyBudgets = _field
+	return nil
+}
+func (p *Job) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TeeType = _field
+	return nil
+}
+func (p *Job) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Profile = _field
+	return nil
+}
+func (p *Job) ReadField15(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Spot = _field
+	return nil
+}
+func (p *Job) ReadField16(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Attempts = _field
+	return nil
+}
+func (p *Job) ReadField17(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Zone = _field
+	return nil
+}
+func (p *Job) ReadField18(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.NetworkMode = _field
+	return nil
+}
 
 func (p *Job) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -350,6 +679,50 @@ func (p *Job) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 7
 			goto WriteFieldError
 		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+		if err = p.writeField17(oprot); err != nil {
+			fieldId = 17
+			goto WriteFieldError
+		}
+		if err = p.writeField18(oprot); err != nil {
+			fieldId = 18
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -487,32 +860,270 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *Job) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *Job) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("failure_reason", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("Job(%+v)", *p)
-
-}
-
-type SubmitJobRequest struct {
-	JupyterFileName string `thrift:"jupyter_file_name,1" form:"filename" json:"filename" vd:"len($) > 0 && len($) < 128 && regexp('^.*\\.ipynb$') && !regexp('.*\\.\\..*')"`
-	Creator         string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
-	AccessToken     string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
-}
-
-func NewSubmitJobRequest() *SubmitJobRequest {
-	return &SubmitJobRequest{}
+	if err := oprot.WriteString(p.FailureReason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *SubmitJobRequest) GetJupyterFileName() (v string) {
-	return p.JupyterFileName
+func (p *Job) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("policy_provider", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PolicyProvider); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *Job) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("policy_version", thrift.I64, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.PolicyVersion); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *Job) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("datasets", thrift.LIST, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Datasets)); err != nil {
+		return err
+	}
+	for _, v := range p.Datasets {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *Job) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("privacy_budgets", thrift.LIST, 12); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.PrivacyBudgets)); err != nil {
+		return err
+	}
+	for _, v := range p.PrivacyBudgets {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *Job) writeField13(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tee_type", thrift.STRING, 13); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TeeType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *Job) writeField14(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("profile", thrift.STRING, 14); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Profile); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *Job) writeField15(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("spot", thrift.BOOL, 15); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Spot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+
+func (p *Job) writeField16(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attempts", thrift.I32, 16); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Attempts); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
+}
+
+func (p *Job) writeField17(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("zone", thrift.STRING, 17); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Zone); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
+}
+
+func (p *Job) writeField18(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("network_mode", thrift.STRING, 18); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NetworkMode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 end error: ", p), err)
+}
+
+func (p *Job) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Job(%+v)", *p)
+
+}
+
+type SubmitJobRequest struct {
+	JupyterFileName string   `thrift:"jupyter_file_name,1" form:"filename" json:"filename" vd:"len($) > 0 && len($) < 128 && regexp('^.*\\.ipynb$') && !regexp('.*\\.\\..*')"`
+	Creator         string   `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	PolicyProvider  string   `thrift:"policy_provider,3" form:"provider" json:"provider" vd:"len($) < 32 && !regexp('.*\\.\\..*')"`
+	Datasets        []string `thrift:"datasets,4" form:"datasets" json:"datasets"`
+	PrivacyBudgets  []string `thrift:"privacy_budgets,5" form:"privacy_budgets" json:"privacy_budgets"`
+	TeeType         string   `thrift:"tee_type,6" form:"tee_type" json:"tee_type"`
+	Profile         string   `thrift:"profile,7" form:"profile" json:"profile"`
+	Spot            bool     `thrift:"spot,8" form:"spot" json:"spot"`
+	NetworkMode     string   `thrift:"network_mode,9" form:"network_mode" json:"network_mode"`
+	AccessToken     string   `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewSubmitJobRequest() *SubmitJobRequest {
+	return &SubmitJobRequest{}
+}
+
+func (p *SubmitJobRequest) GetJupyterFileName() (v string) {
+	return p.JupyterFileName
 }
 
 func (p *SubmitJobRequest) GetCreator() (v string) {
 	return p.Creator
 }
 
+func (p *SubmitJobRequest) GetPolicyProvider() (v string) {
+	return p.PolicyProvider
+}
+
+func (p *SubmitJobRequest) GetDatasets() (v []string) {
+	return p.Datasets
+}
+
+func (p *SubmitJobRequest) GetPrivacyBudgets() (v []string) {
+	return p.PrivacyBudgets
+}
+
+func (p *SubmitJobRequest) GetTeeType() (v string) {
+	return p.TeeType
+}
+
+func (p *SubmitJobRequest) GetProfile() (v string) {
+	return p.Profile
+}
+
+func (p *SubmitJobRequest) GetSpot() (v bool) {
+	return p.Spot
+}
+
+func (p *SubmitJobRequest) GetNetworkMode() (v string) {
+	return p.NetworkMode
+}
+
 func (p *SubmitJobRequest) GetAccessToken() (v string) {
 	return p.AccessToken
 }
@@ -520,6 +1131,13 @@ func (p *SubmitJobRequest) GetAccessToken() (v string) {
 var fieldIDToName_SubmitJobRequest = map[int16]string{
 	1:   "jupyter_file_name",
 	2:   "creator",
+	3:   "policy_provider",
+	4:   "datasets",
+	5:   "privacy_budgets",
+	6:   "tee_type",
+	7:   "profile",
+	8:   "spot",
+	9:   "network_mode",
 	255: "access_token",
 }
 
@@ -559,6 +1177,62 @@ func (p *SubmitJobRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField255(iprot); err != nil {
@@ -625,7 +1299,7 @@ func (p *SubmitJobRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.Creator = _field
 	return nil
 }
-func (p *SubmitJobRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *SubmitJobRequest) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -633,55 +1307,184 @@ func (p *SubmitJobRequest) ReadField255(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.AccessToken = _field
+	p.PolicyProvider = _field
 	return nil
 }
-
-func (p *SubmitJobRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitJobRequest"); err != nil {
-		goto WriteStructBeginError
+func (p *SubmitJobRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
-			goto WriteFieldError
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
 		}
+
+		_field = append(_field, _elem)
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
+	p.Datasets = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-
-func (p *SubmitJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("jupyter_file_name", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.JupyterFileName); err != nil {
+func (p *SubmitJobRequest) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.PrivacyBudgets = _field
+	return nil
+}
+func (p *SubmitJobRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TeeType = _field
+	return nil
+}
+func (p *SubmitJobRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Profile = _field
+	return nil
+}
+func (p *SubmitJobRequest) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Spot = _field
+	return nil
+}
+func (p *SubmitJobRequest) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.NetworkMode = _field
+	return nil
+}
+func (p *SubmitJobRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *SubmitJobRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitJobRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SubmitJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("jupyter_file_name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.JupyterFileName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -707,6 +1510,141 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
+func (p *SubmitJobRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("policy_provider", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PolicyProvider); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SubmitJobRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("datasets", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Datasets)); err != nil {
+		return err
+	}
+	for _, v := range p.Datasets {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SubmitJobRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("privacy_budgets", thrift.LIST, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.PrivacyBudgets)); err != nil {
+		return err
+	}
+	for _, v := range p.PrivacyBudgets {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SubmitJobRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tee_type", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TeeType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SubmitJobRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("profile", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Profile); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *SubmitJobRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("spot", thrift.BOOL, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Spot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *SubmitJobRequest) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("network_mode", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NetworkMode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
 func (p *SubmitJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
 		goto WriteFieldBeginError
@@ -1958,59 +2896,35 @@ func (p *DeleteJobResponse) String() string {
 
 }
 
-type UpdateJobStatusRequest struct {
-	UUID              string    `thrift:"uuid,1" form:"uuid" json:"uuid" query:"uuid"`
-	Status            JobStatus `thrift:"status,2" form:"status" json:"status" query:"status"`
-	DockerImage       string    `thrift:"docker_image,3" form:"image" json:"image" query:"image"`
-	DockerImageDigest string    `thrift:"docker_image_digest,4" form:"digest" json:"digest" query:"digest"`
-	Creator           string    `thrift:"creator,5" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
-	AttestationToken  string    `thrift:"attestation_token,6" form:"token" json:"token" query:"token"`
-	AccessToken       string    `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+type CancelJobRequest struct {
+	UUID        string `thrift:"uuid,1" form:"uuid" json:"uuid" query:"uuid"`
+	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
 }
 
-func NewUpdateJobStatusRequest() *UpdateJobStatusRequest {
-	return &UpdateJobStatusRequest{}
+func NewCancelJobRequest() *CancelJobRequest {
+	return &CancelJobRequest{}
 }
 
-func (p *UpdateJobStatusRequest) GetUUID() (v string) {
+func (p *CancelJobRequest) GetUUID() (v string) {
 	return p.UUID
 }
 
-func (p *UpdateJobStatusRequest) GetStatus() (v JobStatus) {
-	return p.Status
+func (p *CancelJobRequest) GetCreator() (v string) {
+	return p.Creator
 }
 
-func (p *UpdateJobStatusRequest) GetDockerImage() (v string) {
-	return p.DockerImage
+func (p *CancelJobRequest) GetAccessToken() (v string) {
+	return p.AccessToken
 }
 
-func (p *UpdateJobStatusRequest) GetDockerImageDigest() (v string) {
-	return p.DockerImageDigest
-}
-
-func (p *UpdateJobStatusRequest) GetCreator() (v string) {
-	return p.Creator
-}
-
-func (p *UpdateJobStatusRequest) GetAttestationToken() (v string) {
-	return p.AttestationToken
-}
-
-func (p *UpdateJobStatusRequest) GetAccessToken() (v string) {
-	return p.AccessToken
-}
-
-var fieldIDToName_UpdateJobStatusRequest = map[int16]string{
+var fieldIDToName_CancelJobRequest = map[int16]string{
 	1:   "uuid",
-	2:   "status",
-	3:   "docker_image",
-	4:   "docker_image_digest",
-	5:   "creator",
-	6:   "attestation_token",
+	2:   "creator",
 	255: "access_token",
 }
 
-func (p *UpdateJobStatusRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *CancelJobRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2039,40 +2953,8 @@ func (p *UpdateJobStatusRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField6(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -2110,7 +2992,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateJobStatusRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelJobRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2119,10 +3001,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateJobStatusRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CancelJobRequest[fieldId]))
 }
 
-func (p *UpdateJobStatusRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *CancelJobRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2133,40 +3015,7 @@ func (p *UpdateJobStatusRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.UUID = _field
 	return nil
 }
-func (p *UpdateJobStatusRequest) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field JobStatus
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = JobStatus(v)
-	}
-	p.Status = _field
-	return nil
-}
-func (p *UpdateJobStatusRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.DockerImage = _field
-	return nil
-}
-func (p *UpdateJobStatusRequest) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.DockerImageDigest = _field
-	return nil
-}
-func (p *UpdateJobStatusRequest) ReadField5(iprot thrift.TProtocol) error {
+func (p *CancelJobRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2177,18 +3026,7 @@ func (p *UpdateJobStatusRequest) ReadField5(iprot thrift.TProtocol) error {
 	p.Creator = _field
 	return nil
 }
-func (p *UpdateJobStatusRequest) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.AttestationToken = _field
-	return nil
-}
-func (p *UpdateJobStatusRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *CancelJobRequest) ReadField255(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2200,9 +3038,9 @@ func (p *UpdateJobStatusRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UpdateJobStatusRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *CancelJobRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateJobStatusRequest"); err != nil {
+	if err = oprot.WriteStructBegin("CancelJobRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2214,22 +3052,6 @@ func (p *UpdateJobStatusRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -2252,7 +3074,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateJobStatusRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CancelJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2269,11 +3091,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateJobStatusRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.I32, 2); err != nil {
+func (p *CancelJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(int32(p.Status)); err != nil {
+	if err := oprot.WriteString(p.Creator); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2286,75 +3108,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *UpdateJobStatusRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("docker_image", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.DockerImage); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *UpdateJobStatusRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("docker_image_digest", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.DockerImageDigest); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *UpdateJobStatusRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Creator); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *UpdateJobStatusRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("attestation_token", thrift.STRING, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.AttestationToken); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-
-func (p *UpdateJobStatusRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *CancelJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2371,37 +3125,37 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *UpdateJobStatusRequest) String() string {
+func (p *CancelJobRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateJobStatusRequest(%+v)", *p)
+	return fmt.Sprintf("CancelJobRequest(%+v)", *p)
 
 }
 
-type UpdateJobStatusResponse struct {
+type CancelJobResponse struct {
 	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
 	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
 }
 
-func NewUpdateJobStatusResponse() *UpdateJobStatusResponse {
-	return &UpdateJobStatusResponse{}
+func NewCancelJobResponse() *CancelJobResponse {
+	return &CancelJobResponse{}
 }
 
-func (p *UpdateJobStatusResponse) GetCode() (v int32) {
+func (p *CancelJobResponse) GetCode() (v int32) {
 	return p.Code
 }
 
-func (p *UpdateJobStatusResponse) GetMsg() (v string) {
+func (p *CancelJobResponse) GetMsg() (v string) {
 	return p.Msg
 }
 
-var fieldIDToName_UpdateJobStatusResponse = map[int16]string{
+var fieldIDToName_CancelJobResponse = map[int16]string{
 	1: "code",
 	2: "msg",
 }
 
-func (p *UpdateJobStatusResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *CancelJobResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2455,7 +3209,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateJobStatusResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelJobResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2465,7 +3219,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateJobStatusResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *CancelJobResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -2476,7 +3230,7 @@ func (p *UpdateJobStatusResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Code = _field
 	return nil
 }
-func (p *UpdateJobStatusResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *CancelJobResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2488,9 +3242,9 @@ func (p *UpdateJobStatusResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UpdateJobStatusResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *CancelJobResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateJobStatusResponse"); err != nil {
+	if err = oprot.WriteStructBegin("CancelJobResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2520,7 +3274,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateJobStatusResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CancelJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2537,7 +3291,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateJobStatusResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CancelJobResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2554,47 +3308,77 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *UpdateJobStatusResponse) String() string {
+func (p *CancelJobResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateJobStatusResponse(%+v)", *p)
+	return fmt.Sprintf("CancelJobResponse(%+v)", *p)
 
 }
 
-type QueryJobOutputRequest struct {
-	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id"`
-	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
-	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+type UpdateJobStatusRequest struct {
+	UUID              string    `thrift:"uuid,1" form:"uuid" json:"uuid" query:"uuid"`
+	Status            JobStatus `thrift:"status,2" form:"status" json:"status" query:"status"`
+	DockerImage       string    `thrift:"docker_image,3" form:"image" json:"image" query:"image"`
+	DockerImageDigest string    `thrift:"docker_image_digest,4" form:"digest" json:"digest" query:"digest"`
+	Creator           string    `thrift:"creator,5" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	AttestationToken  string    `thrift:"attestation_token,6" form:"token" json:"token" query:"token"`
+	FailureReason     string    `thrift:"failure_reason,7" form:"reason" json:"reason" query:"reason"`
+	AccessToken       string    `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
 }
 
-func NewQueryJobOutputRequest() *QueryJobOutputRequest {
-	return &QueryJobOutputRequest{}
+func NewUpdateJobStatusRequest() *UpdateJobStatusRequest {
+	return &UpdateJobStatusRequest{}
 }
 
-func (p *QueryJobOutputRequest) GetID() (v int64) {
-	return p.ID
+func (p *UpdateJobStatusRequest) GetUUID() (v string) {
+	return p.UUID
 }
 
-func (p *QueryJobOutputRequest) GetCreator() (v string) {
-	return p.Creator
+func (p *UpdateJobStatusRequest) GetStatus() (v JobStatus) {
+	return p.Status
 }
 
-func (p *QueryJobOutputRequest) GetAccessToken() (v string) {
-	return p.AccessToken
+func (p *UpdateJobStatusRequest) GetDockerImage() (v string) {
+	return p.DockerImage
 }
 
-var fieldIDToName_QueryJobOutputRequest = map[int16]string{
-	1:   "id",
-	2:   "creator",
-	255: "access_token",
+func (p *UpdateJobStatusRequest) GetDockerImageDigest() (v string) {
+	return p.DockerImageDigest
 }
 
-func (p *QueryJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateJobStatusRequest) GetCreator() (v string) {
+	return p.Creator
+}
 
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetAccessToken bool = false
+func (p *UpdateJobStatusRequest) GetAttestationToken() (v string) {
+	return p.AttestationToken
+}
+
+func (p *UpdateJobStatusRequest) GetFailureReason() (v string) {
+	return p.FailureReason
+}
+
+func (p *UpdateJobStatusRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_UpdateJobStatusRequest = map[int16]string{
+	1:   "uuid",
+	2:   "status",
+	3:   "docker_image",
+	4:   "docker_image_digest",
+	5:   "creator",
+	6:   "attestation_token",
+	7:   "failure_reason",
+	255: "access_token",
+}
+
+func (p *UpdateJobStatusRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2611,7 +3395,7 @@ func (p *QueryJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2619,13 +3403,53 @@ func (p *QueryJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField255(iprot); err != nil {
@@ -2658,7 +3482,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobOutputRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateJobStatusRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2667,21 +3491,54 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryJobOutputRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateJobStatusRequest[fieldId]))
 }
 
-func (p *QueryJobOutputRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateJobStatusRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.UUID = _field
 	return nil
 }
-func (p *QueryJobOutputRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *UpdateJobStatusRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field JobStatus
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = JobStatus(v)
+	}
+	p.Status = _field
+	return nil
+}
+func (p *UpdateJobStatusRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DockerImage = _field
+	return nil
+}
+func (p *UpdateJobStatusRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DockerImageDigest = _field
+	return nil
+}
+func (p *UpdateJobStatusRequest) ReadField5(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2692,7 +3549,29 @@ func (p *QueryJobOutputRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.Creator = _field
 	return nil
 }
-func (p *QueryJobOutputRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *UpdateJobStatusRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AttestationToken = _field
+	return nil
+}
+func (p *UpdateJobStatusRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FailureReason = _field
+	return nil
+}
+func (p *UpdateJobStatusRequest) ReadField255(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2704,9 +3583,9 @@ func (p *QueryJobOutputRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryJobOutputRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateJobStatusRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobOutputRequest"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateJobStatusRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2718,6 +3597,26 @@ func (p *QueryJobOutputRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -2740,11 +3639,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryJobOutputRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *UpdateJobStatusRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := oprot.WriteString(p.UUID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2757,11 +3656,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *QueryJobOutputRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+func (p *UpdateJobStatusRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Creator); err != nil {
+	if err := oprot.WriteI32(int32(p.Status)); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2774,11 +3673,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *QueryJobOutputRequest) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+func (p *UpdateJobStatusRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("docker_image", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.AccessToken); err != nil {
+	if err := oprot.WriteString(p.DockerImage); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2786,54 +3685,127 @@ func (p *QueryJobOutputRequest) writeField255(oprot thrift.TProtocol) (err error
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *QueryJobOutputRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *UpdateJobStatusRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("docker_image_digest", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("QueryJobOutputRequest(%+v)", *p)
-
+	if err := oprot.WriteString(p.DockerImageDigest); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-type QueryJobOutputResponse struct {
-	Code     int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
-	Msg      string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
-	Size     int64  `thrift:"size,3" form:"size" json:"size" query:"size"`
-	Filename string `thrift:"filename,4" form:"filename" json:"filename" query:"filename"`
+func (p *UpdateJobStatusRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func NewQueryJobOutputResponse() *QueryJobOutputResponse {
-	return &QueryJobOutputResponse{}
+func (p *UpdateJobStatusRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attestation_token", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AttestationToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *QueryJobOutputResponse) GetCode() (v int32) {
-	return p.Code
+func (p *UpdateJobStatusRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("failure_reason", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FailureReason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *QueryJobOutputResponse) GetMsg() (v string) {
-	return p.Msg
+func (p *UpdateJobStatusRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *QueryJobOutputResponse) GetSize() (v int64) {
-	return p.Size
+func (p *UpdateJobStatusRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateJobStatusRequest(%+v)", *p)
+
 }
 
-func (p *QueryJobOutputResponse) GetFilename() (v string) {
-	return p.Filename
+type UpdateJobStatusResponse struct {
+	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
 }
 
-var fieldIDToName_QueryJobOutputResponse = map[int16]string{
+func NewUpdateJobStatusResponse() *UpdateJobStatusResponse {
+	return &UpdateJobStatusResponse{}
+}
+
+func (p *UpdateJobStatusResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *UpdateJobStatusResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+var fieldIDToName_UpdateJobStatusResponse = map[int16]string{
 	1: "code",
 	2: "msg",
-	3: "size",
-	4: "filename",
 }
 
-func (p *QueryJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateJobStatusResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2868,22 +3840,6 @@ func (p *QueryJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2903,7 +3859,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobOutputResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateJobStatusResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2913,7 +3869,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *QueryJobOutputResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateJobStatusResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -2924,7 +3880,7 @@ func (p *QueryJobOutputResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Code = _field
 	return nil
 }
-func (p *QueryJobOutputResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *UpdateJobStatusResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2935,32 +3891,10 @@ func (p *QueryJobOutputResponse) ReadField2(iprot thrift.TProtocol) error {
 	p.Msg = _field
 	return nil
 }
-func (p *QueryJobOutputResponse) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Size = _field
-	return nil
-}
-func (p *QueryJobOutputResponse) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Filename = _field
-	return nil
-}
 
-func (p *QueryJobOutputResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateJobStatusResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobOutputResponse"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateJobStatusResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2972,14 +3906,6 @@ func (p *QueryJobOutputResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2998,7 +3924,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryJobOutputResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UpdateJobStatusResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3015,7 +3941,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *QueryJobOutputResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *UpdateJobStatusResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3032,89 +3958,49 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *QueryJobOutputResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("size", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.Size); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *QueryJobOutputResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("filename", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Filename); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *QueryJobOutputResponse) String() string {
+func (p *UpdateJobStatusResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryJobOutputResponse(%+v)", *p)
+	return fmt.Sprintf("UpdateJobStatusResponse(%+v)", *p)
 
 }
 
-type DownloadJobOutputRequest struct {
-	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
+type QueryJobOutputRequest struct {
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id"`
 	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
-	Offset      int64  `thrift:"offset,3" form:"offset" json:"offset" query:"offset"`
-	Chunk       int64  `thrift:"chunk,4" form:"chunk" json:"chunk" query:"chunk" vd:"$>0 && $ < 5242880"`
+	Filename    string `thrift:"filename,3" form:"filename" json:"filename" query:"filename" vd:"len($) < 128 && !regexp('.*\\.\\..*')"`
 	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
 }
 
-func NewDownloadJobOutputRequest() *DownloadJobOutputRequest {
-	return &DownloadJobOutputRequest{}
+func NewQueryJobOutputRequest() *QueryJobOutputRequest {
+	return &QueryJobOutputRequest{}
 }
 
-func (p *DownloadJobOutputRequest) GetID() (v int64) {
+func (p *QueryJobOutputRequest) GetID() (v int64) {
 	return p.ID
 }
 
-func (p *DownloadJobOutputRequest) GetCreator() (v string) {
+func (p *QueryJobOutputRequest) GetCreator() (v string) {
 	return p.Creator
 }
 
-func (p *DownloadJobOutputRequest) GetOffset() (v int64) {
-	return p.Offset
-}
-
-func (p *DownloadJobOutputRequest) GetChunk() (v int64) {
-	return p.Chunk
+func (p *QueryJobOutputRequest) GetFilename() (v string) {
+	return p.Filename
 }
 
-func (p *DownloadJobOutputRequest) GetAccessToken() (v string) {
+func (p *QueryJobOutputRequest) GetAccessToken() (v string) {
 	return p.AccessToken
 }
 
-var fieldIDToName_DownloadJobOutputRequest = map[int16]string{
+var fieldIDToName_QueryJobOutputRequest = map[int16]string{
 	1:   "id",
 	2:   "creator",
-	3:   "offset",
-	4:   "chunk",
+	3:   "filename",
 	255: "access_token",
 }
 
-func (p *DownloadJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *QueryJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3151,21 +4037,13 @@ func (p *DownloadJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		case 255:
 			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField255(iprot); err != nil {
@@ -3198,7 +4076,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DownloadJobOutputRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobOutputRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3207,10 +4085,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DownloadJobOutputRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryJobOutputRequest[fieldId]))
 }
 
-func (p *DownloadJobOutputRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *QueryJobOutputRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3221,7 +4099,7 @@ func (p *DownloadJobOutputRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *DownloadJobOutputRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *QueryJobOutputRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3232,29 +4110,18 @@ func (p *DownloadJobOutputRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.Creator = _field
 	return nil
 }
-func (p *DownloadJobOutputRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Offset = _field
-	return nil
-}
-func (p *DownloadJobOutputRequest) ReadField4(iprot thrift.TProtocol) error {
+func (p *QueryJobOutputRequest) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Chunk = _field
+	p.Filename = _field
 	return nil
 }
-func (p *DownloadJobOutputRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *QueryJobOutputRequest) ReadField255(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3266,9 +4133,9 @@ func (p *DownloadJobOutputRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DownloadJobOutputRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *QueryJobOutputRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DownloadJobOutputRequest"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobOutputRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3284,10 +4151,6 @@ func (p *DownloadJobOutputRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -3310,7 +4173,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DownloadJobOutputRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *QueryJobOutputRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3327,7 +4190,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *QueryJobOutputRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3344,11 +4207,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("offset", thrift.I64, 3); err != nil {
+func (p *QueryJobOutputRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("filename", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.Offset); err != nil {
+	if err := oprot.WriteString(p.Filename); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3361,24 +4224,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("chunk", thrift.I64, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.Chunk); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *DownloadJobOutputRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *QueryJobOutputRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3395,43 +4241,49 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputRequest) String() string {
+func (p *QueryJobOutputRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DownloadJobOutputRequest(%+v)", *p)
-
-}
+	return fmt.Sprintf("QueryJobOutputRequest(%+v)", *p)
 
-type DownloadJobOutputResponse struct {
-	Code    int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
-	Msg     string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
-	Content string `thrift:"content,3" form:"content" json:"content" query:"content"`
 }
 
-func NewDownloadJobOutputResponse() *DownloadJobOutputResponse {
-	return &DownloadJobOutputResponse{}
+type QueryJobOutputResponse struct {
+	Code     int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg      string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Size     int64  `thrift:"size,3" form:"size" json:"size" query:"size"`
+	Filename string `thrift:"filename,4" form:"filename" json:"filename" query:"filename"`
 }
 
-func (p *DownloadJobOutputResponse) GetCode() (v int32) {
+func NewQueryJobOutputResponse() *QueryJobOutputResponse {
+	return &QueryJobOutputResponse{}
+}
+
+func (p *QueryJobOutputResponse) GetCode() (v int32) {
 	return p.Code
 }
 
-func (p *DownloadJobOutputResponse) GetMsg() (v string) {
+func (p *QueryJobOutputResponse) GetMsg() (v string) {
 	return p.Msg
 }
 
-func (p *DownloadJobOutputResponse) GetContent() (v string) {
-	return p.Content
+func (p *QueryJobOutputResponse) GetSize() (v int64) {
+	return p.Size
 }
 
-var fieldIDToName_DownloadJobOutputResponse = map[int16]string{
+func (p *QueryJobOutputResponse) GetFilename() (v string) {
+	return p.Filename
+}
+
+var fieldIDToName_QueryJobOutputResponse = map[int16]string{
 	1: "code",
 	2: "msg",
-	3: "content",
+	3: "size",
+	4: "filename",
 }
 
-func (p *DownloadJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *QueryJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3467,13 +4319,21 @@ func (p *DownloadJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3493,7 +4353,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DownloadJobOutputResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobOutputResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3503,7 +4363,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DownloadJobOutputResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *QueryJobOutputResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -3514,7 +4374,7 @@ func (p *DownloadJobOutputResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Code = _field
 	return nil
 }
-func (p *DownloadJobOutputResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *QueryJobOutputResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3525,7 +4385,18 @@ func (p *DownloadJobOutputResponse) ReadField2(iprot thrift.TProtocol) error {
 	p.Msg = _field
 	return nil
 }
-func (p *DownloadJobOutputResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *QueryJobOutputResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Size = _field
+	return nil
+}
+func (p *QueryJobOutputResponse) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3533,13 +4404,13 @@ func (p *DownloadJobOutputResponse) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Content = _field
+	p.Filename = _field
 	return nil
 }
 
-func (p *DownloadJobOutputResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *QueryJobOutputResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DownloadJobOutputResponse"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobOutputResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3555,6 +4426,10 @@ func (p *DownloadJobOutputResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3573,7 +4448,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DownloadJobOutputResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *QueryJobOutputResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3590,7 +4465,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *QueryJobOutputResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3607,11 +4482,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
+func (p *QueryJobOutputResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("size", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Content); err != nil {
+	if err := oprot.WriteI64(p.Size); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3624,40 +4499,82 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputResponse) String() string {
+func (p *QueryJobOutputResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("filename", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Filename); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *QueryJobOutputResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DownloadJobOutputResponse(%+v)", *p)
+	return fmt.Sprintf("QueryJobOutputResponse(%+v)", *p)
 
 }
 
-type QueryJobAttestationRequest struct {
-	ID      int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
-	Creator string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+type DownloadJobOutputRequest struct {
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
+	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	Offset      int64  `thrift:"offset,3" form:"offset" json:"offset" query:"offset"`
+	Chunk       int64  `thrift:"chunk,4" form:"chunk" json:"chunk" query:"chunk" vd:"$>0 && $ < 5242880"`
+	Filename    string `thrift:"filename,5" form:"filename" json:"filename" query:"filename" vd:"len($) < 128 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
 }
 
-func NewQueryJobAttestationRequest() *QueryJobAttestationRequest {
-	return &QueryJobAttestationRequest{}
+func NewDownloadJobOutputRequest() *DownloadJobOutputRequest {
+	return &DownloadJobOutputRequest{}
 }
 
-func (p *QueryJobAttestationRequest) GetID() (v int64) {
+func (p *DownloadJobOutputRequest) GetID() (v int64) {
 	return p.ID
 }
 
-func (p *QueryJobAttestationRequest) GetCreator() (v string) {
+func (p *DownloadJobOutputRequest) GetCreator() (v string) {
 	return p.Creator
 }
 
-var fieldIDToName_QueryJobAttestationRequest = map[int16]string{
-	1: "id",
-	2: "creator",
+func (p *DownloadJobOutputRequest) GetOffset() (v int64) {
+	return p.Offset
 }
 
-func (p *QueryJobAttestationRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *DownloadJobOutputRequest) GetChunk() (v int64) {
+	return p.Chunk
+}
+
+func (p *DownloadJobOutputRequest) GetFilename() (v string) {
+	return p.Filename
+}
+
+func (p *DownloadJobOutputRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_DownloadJobOutputRequest = map[int16]string{
+	1:   "id",
+	2:   "creator",
+	3:   "offset",
+	4:   "chunk",
+	5:   "filename",
+	255: "access_token",
+}
+
+func (p *DownloadJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetAccessToken bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -3689,6 +4606,39 @@ func (p *QueryJobAttestationRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3702,13 +4652,17 @@ func (p *QueryJobAttestationRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobAttestationRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DownloadJobOutputRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3716,9 +4670,11 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DownloadJobOutputRequest[fieldId]))
 }
 
-func (p *QueryJobAttestationRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *DownloadJobOutputRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3729,7 +4685,7 @@ func (p *QueryJobAttestationRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *QueryJobAttestationRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *DownloadJobOutputRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3740,10 +4696,54 @@ func (p *QueryJobAttestationRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.Creator = _field
 	return nil
 }
+func (p *DownloadJobOutputRequest) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *QueryJobAttestationRequest) Write(oprot thrift.TProtocol) (err error) {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Offset = _field
+	return nil
+}
+func (p *DownloadJobOutputRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Chunk = _field
+	return nil
+}
+func (p *DownloadJobOutputRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Filename = _field
+	return nil
+}
+func (p *DownloadJobOutputRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *DownloadJobOutputRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobAttestationRequest"); err != nil {
+	if err = oprot.WriteStructBegin("DownloadJobOutputRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3755,6 +4755,22 @@ func (p *QueryJobAttestationRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3773,7 +4789,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryJobAttestationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DownloadJobOutputRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3790,7 +4806,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *QueryJobAttestationRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *DownloadJobOutputRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3807,43 +4823,111 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *QueryJobAttestationRequest) String() string {
+func (p *DownloadJobOutputRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *DownloadJobOutputRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("chunk", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Chunk); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *DownloadJobOutputRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("filename", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Filename); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *DownloadJobOutputRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *DownloadJobOutputRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryJobAttestationRequest(%+v)", *p)
+	return fmt.Sprintf("DownloadJobOutputRequest(%+v)", *p)
 
 }
 
-type QueryJobAttestationResponse struct {
-	Code  int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
-	Msg   string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
-	Token string `thrift:"token,3" form:"token" json:"token" query:"token"`
+type DownloadJobOutputResponse struct {
+	Code    int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg     string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Content string `thrift:"content,3" form:"content" json:"content" query:"content"`
 }
 
-func NewQueryJobAttestationResponse() *QueryJobAttestationResponse {
-	return &QueryJobAttestationResponse{}
+func NewDownloadJobOutputResponse() *DownloadJobOutputResponse {
+	return &DownloadJobOutputResponse{}
 }
 
-func (p *QueryJobAttestationResponse) GetCode() (v int32) {
+func (p *DownloadJobOutputResponse) GetCode() (v int32) {
 	return p.Code
 }
 
-func (p *QueryJobAttestationResponse) GetMsg() (v string) {
+func (p *DownloadJobOutputResponse) GetMsg() (v string) {
 	return p.Msg
 }
 
-func (p *QueryJobAttestationResponse) GetToken() (v string) {
-	return p.Token
+func (p *DownloadJobOutputResponse) GetContent() (v string) {
+	return p.Content
 }
 
-var fieldIDToName_QueryJobAttestationResponse = map[int16]string{
+var fieldIDToName_DownloadJobOutputResponse = map[int16]string{
 	1: "code",
 	2: "msg",
-	3: "token",
+	3: "content",
 }
 
-func (p *QueryJobAttestationResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *DownloadJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3905,7 +4989,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobAttestationResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DownloadJobOutputResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3915,7 +4999,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *QueryJobAttestationResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *DownloadJobOutputResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -3926,7 +5010,7 @@ func (p *QueryJobAttestationResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Code = _field
 	return nil
 }
-func (p *QueryJobAttestationResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *DownloadJobOutputResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3937,7 +5021,7 @@ func (p *QueryJobAttestationResponse) ReadField2(iprot thrift.TProtocol) error {
 	p.Msg = _field
 	return nil
 }
-func (p *QueryJobAttestationResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *DownloadJobOutputResponse) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3945,13 +5029,13 @@ func (p *QueryJobAttestationResponse) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Token = _field
+	p.Content = _field
 	return nil
 }
 
-func (p *QueryJobAttestationResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *DownloadJobOutputResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobAttestationResponse"); err != nil {
+	if err = oprot.WriteStructBegin("DownloadJobOutputResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3985,7 +5069,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryJobAttestationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DownloadJobOutputResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4002,7 +5086,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *QueryJobAttestationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *DownloadJobOutputResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4019,11 +5103,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *QueryJobAttestationResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("token", thrift.STRING, 3); err != nil {
+func (p *DownloadJobOutputResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Token); err != nil {
+	if err := oprot.WriteString(p.Content); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4036,529 +5120,7543 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *QueryJobAttestationResponse) String() string {
+func (p *DownloadJobOutputResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryJobAttestationResponse(%+v)", *p)
+	return fmt.Sprintf("DownloadJobOutputResponse(%+v)", *p)
 
 }
 
-type JobHandler interface {
-	SubmitJob(ctx context.Context, req *SubmitJobRequest) (r *SubmitJobResponse, err error)
+type JobArtifact struct {
+	Filename       string `thrift:"filename,1" form:"filename" json:"filename" query:"filename"`
+	Size           int64  `thrift:"size,2" form:"size" json:"size" query:"size"`
+	Sha256         string `thrift:"sha256,3" form:"sha256" json:"sha256" query:"sha256"`
+	AttestedSha256 string `thrift:"attested_sha256,4" form:"attested_sha256" json:"attested_sha256" query:"attested_sha256"`
+	Status         string `thrift:"status,5" form:"status" json:"status" query:"status"`
+}
 
-	QueryJob(ctx context.Context, req *QueryJobRequest) (r *QueryJobResponse, err error)
+func NewJobArtifact() *JobArtifact {
+	return &JobArtifact{}
+}
 
-	DeleteJob(ctx context.Context, req *DeleteJobRequest) (r *DeleteJobResponse, err error)
+func (p *JobArtifact) GetFilename() (v string) {
+	return p.Filename
+}
 
-	UpdateJobStatus(ctx context.Context, req *UpdateJobStatusRequest) (r *UpdateJobStatusResponse, err error)
+func (p *JobArtifact) GetSize() (v int64) {
+	return p.Size
+}
 
-	QueryJobOutputAttr(ctx context.Context, req *QueryJobOutputRequest) (r *QueryJobOutputResponse, err error)
+func (p *JobArtifact) GetSha256() (v string) {
+	return p.Sha256
+}
 
-	DownloadJobOutput(ctx context.Context, req *DownloadJobOutputRequest) (r *DownloadJobOutputResponse, err error)
+func (p *JobArtifact) GetAttestedSha256() (v string) {
+	return p.AttestedSha256
+}
 
-	QueryJobAttestationReport(ctx context.Context, req *QueryJobAttestationRequest) (r *QueryJobAttestationResponse, err error)
+func (p *JobArtifact) GetStatus() (v string) {
+	return p.Status
 }
 
-type JobHandlerClient struct {
-	c thrift.TClient
+var fieldIDToName_JobArtifact = map[int16]string{
+	1: "filename",
+	2: "size",
+	3: "sha256",
+	4: "attested_sha256",
+	5: "status",
 }
 
-func NewJobHandlerClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *JobHandlerClient {
-	return &JobHandlerClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *JobArtifact) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-}
 
-func NewJobHandlerClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *JobHandlerClient {
-	return &JobHandlerClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobArtifact[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func NewJobHandlerClient(c thrift.TClient) *JobHandlerClient {
-	return &JobHandlerClient{
-		c: c,
+func (p *JobArtifact) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.Filename = _field
+	return nil
 }
+func (p *JobArtifact) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *JobHandlerClient) Client_() thrift.TClient {
-	return p.c
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Size = _field
+	return nil
 }
+func (p *JobArtifact) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *JobHandlerClient) SubmitJob(ctx context.Context, req *SubmitJobRequest) (r *SubmitJobResponse, err error) {
-	var _args JobHandlerSubmitJobArgs
-	_args.Req = req
-	var _result JobHandlerSubmitJobResult
-	if err = p.Client_().Call(ctx, "SubmitJob", &_args, &_result); err != nil {
-		return
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.Sha256 = _field
+	return nil
 }
-func (p *JobHandlerClient) QueryJob(ctx context.Context, req *QueryJobRequest) (r *QueryJobResponse, err error) {
-	var _args JobHandlerQueryJobArgs
-	_args.Req = req
-	var _result JobHandlerQueryJobResult
-	if err = p.Client_().Call(ctx, "QueryJob", &_args, &_result); err != nil {
-		return
+func (p *JobArtifact) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.AttestedSha256 = _field
+	return nil
 }
-func (p *JobHandlerClient) DeleteJob(ctx context.Context, req *DeleteJobRequest) (r *DeleteJobResponse, err error) {
-	var _args JobHandlerDeleteJobArgs
-	_args.Req = req
-	var _result JobHandlerDeleteJobResult
-	if err = p.Client_().Call(ctx, "DeleteJob", &_args, &_result); err != nil {
-		return
+func (p *JobArtifact) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.Status = _field
+	return nil
 }
-func (p *JobHandlerClient) UpdateJobStatus(ctx context.Context, req *UpdateJobStatusRequest) (r *UpdateJobStatusResponse, err error) {
-	var _args JobHandlerUpdateJobStatusArgs
-	_args.Req = req
-	var _result JobHandlerUpdateJobStatusResult
-	if err = p.Client_().Call(ctx, "UpdateJobStatus", &_args, &_result); err != nil {
-		return
+
+func (p *JobArtifact) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("JobArtifact"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *JobHandlerClient) QueryJobOutputAttr(ctx context.Context, req *QueryJobOutputRequest) (r *QueryJobOutputResponse, err error) {
-	var _args JobHandlerQueryJobOutputAttrArgs
-	_args.Req = req
-	var _result JobHandlerQueryJobOutputAttrResult
-	if err = p.Client_().Call(ctx, "QueryJobOutputAttr", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *JobHandlerClient) DownloadJobOutput(ctx context.Context, req *DownloadJobOutputRequest) (r *DownloadJobOutputResponse, err error) {
-	var _args JobHandlerDownloadJobOutputArgs
-	_args.Req = req
-	var _result JobHandlerDownloadJobOutputResult
-	if err = p.Client_().Call(ctx, "DownloadJobOutput", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *JobHandlerClient) QueryJobAttestationReport(ctx context.Context, req *QueryJobAttestationRequest) (r *QueryJobAttestationResponse, err error) {
-	var _args JobHandlerQueryJobAttestationReportArgs
-	_args.Req = req
-	var _result JobHandlerQueryJobAttestationReportResult
-	if err = p.Client_().Call(ctx, "QueryJobAttestationReport", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
-}
-
-type JobHandlerProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      JobHandler
-}
-
-func (p *JobHandlerProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
-
-func (p *JobHandlerProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *JobArtifact) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("filename", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Filename); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func NewJobHandlerProcessor(handler JobHandler) *JobHandlerProcessor {
-	self := &JobHandlerProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("SubmitJob", &jobHandlerProcessorSubmitJob{handler: handler})
-	self.AddToProcessorMap("QueryJob", &jobHandlerProcessorQueryJob{handler: handler})
-	self.AddToProcessorMap("DeleteJob", &jobHandlerProcessorDeleteJob{handler: handler})
-	self.AddToProcessorMap("UpdateJobStatus", &jobHandlerProcessorUpdateJobStatus{handler: handler})
-	self.AddToProcessorMap("QueryJobOutputAttr", &jobHandlerProcessorQueryJobOutputAttr{handler: handler})
-	self.AddToProcessorMap("DownloadJobOutput", &jobHandlerProcessorDownloadJobOutput{handler: handler})
-	self.AddToProcessorMap("QueryJobAttestationReport", &jobHandlerProcessorQueryJobAttestationReport{handler: handler})
-	return self
-}
-func (p *JobHandlerProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+func (p *JobArtifact) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("size", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if err := oprot.WriteI64(p.Size); err != nil {
+		return err
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-type jobHandlerProcessorSubmitJob struct {
-	handler JobHandler
+func (p *JobArtifact) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sha256", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Sha256); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *jobHandlerProcessorSubmitJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerSubmitJobArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SubmitJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *JobArtifact) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attested_sha256", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerSubmitJobResult{}
-	var retval *SubmitJobResponse
-	if retval, err2 = p.handler.SubmitJob(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SubmitJob: "+err2.Error())
-		oprot.WriteMessageBegin("SubmitJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if err := oprot.WriteString(p.AttestedSha256); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("SubmitJob", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *JobArtifact) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *JobArtifact) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("JobArtifact(%+v)", *p)
+
 }
 
-type jobHandlerProcessorQueryJob struct {
-	handler JobHandler
+type ListJobOutputsRequest struct {
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
+	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
 }
 
-func (p *jobHandlerProcessorQueryJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerQueryJobArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("QueryJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func NewListJobOutputsRequest() *ListJobOutputsRequest {
+	return &ListJobOutputsRequest{}
+}
+
+func (p *ListJobOutputsRequest) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *ListJobOutputsRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *ListJobOutputsRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_ListJobOutputsRequest = map[int16]string{
+	1:   "id",
+	2:   "creator",
+	255: "access_token",
+}
+
+func (p *ListJobOutputsRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerQueryJobResult{}
-	var retval *QueryJobResponse
-	if retval, err2 = p.handler.QueryJob(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJob: "+err2.Error())
-		oprot.WriteMessageBegin("QueryJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListJobOutputsRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListJobOutputsRequest[fieldId]))
+}
+
+func (p *ListJobOutputsRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = v
 	}
-	if err2 = oprot.WriteMessageBegin("QueryJob", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.ID = _field
+	return nil
+}
+func (p *ListJobOutputsRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.Creator = _field
+	return nil
+}
+func (p *ListJobOutputsRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *ListJobOutputsRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListJobOutputsRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return true, err
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type jobHandlerProcessorDeleteJob struct {
-	handler JobHandler
+func (p *ListJobOutputsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *jobHandlerProcessorDeleteJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerDeleteJobArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ListJobOutputsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListJobOutputsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListJobOutputsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListJobOutputsRequest(%+v)", *p)
+
+}
+
+type ListJobOutputsResponse struct {
+	Code         int32          `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg          string         `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Outputs      []*JobArtifact `thrift:"outputs,3" form:"outputs" json:"outputs" query:"outputs"`
+	ManifestRoot string         `thrift:"manifest_root,4" form:"manifest_root" json:"manifest_root" query:"manifest_root"`
+}
+
+func NewListJobOutputsResponse() *ListJobOutputsResponse {
+	return &ListJobOutputsResponse{}
+}
+
+func (p *ListJobOutputsResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *ListJobOutputsResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *ListJobOutputsResponse) GetOutputs() (v []*JobArtifact) {
+	return p.Outputs
+}
+
+func (p *ListJobOutputsResponse) GetManifestRoot() (v string) {
+	return p.ManifestRoot
+}
+
+var fieldIDToName_ListJobOutputsResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "outputs",
+	4: "manifest_root",
+}
+
+func (p *ListJobOutputsResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListJobOutputsResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListJobOutputsResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *ListJobOutputsResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *ListJobOutputsResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*JobArtifact, 0, size)
+	values := make([]JobArtifact, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Outputs = _field
+	return nil
+}
+func (p *ListJobOutputsResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ManifestRoot = _field
+	return nil
+}
+
+func (p *ListJobOutputsResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListJobOutputsResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListJobOutputsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListJobOutputsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListJobOutputsResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("outputs", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Outputs)); err != nil {
+		return err
+	}
+	for _, v := range p.Outputs {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListJobOutputsResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("manifest_root", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ManifestRoot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ListJobOutputsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListJobOutputsResponse(%+v)", *p)
+
+}
+
+type VerifyJobOutputRequest struct {
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
+	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	Filename    string `thrift:"filename,3" form:"filename" json:"filename" query:"filename" vd:"len($) > 0 && len($) < 128 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewVerifyJobOutputRequest() *VerifyJobOutputRequest {
+	return &VerifyJobOutputRequest{}
+}
+
+func (p *VerifyJobOutputRequest) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *VerifyJobOutputRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *VerifyJobOutputRequest) GetFilename() (v string) {
+	return p.Filename
+}
+
+func (p *VerifyJobOutputRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_VerifyJobOutputRequest = map[int16]string{
+	1:   "id",
+	2:   "creator",
+	3:   "filename",
+	255: "access_token",
+}
+
+func (p *VerifyJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerifyJobOutputRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_VerifyJobOutputRequest[fieldId]))
+}
+
+func (p *VerifyJobOutputRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *VerifyJobOutputRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *VerifyJobOutputRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Filename = _field
+	return nil
+}
+func (p *VerifyJobOutputRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *VerifyJobOutputRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VerifyJobOutputRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VerifyJobOutputRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VerifyJobOutputRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *VerifyJobOutputRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("filename", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Filename); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *VerifyJobOutputRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *VerifyJobOutputRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyJobOutputRequest(%+v)", *p)
+
+}
+
+type VerifyJobOutputResponse struct {
+	Code           int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg            string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Verified       bool   `thrift:"verified,3" form:"verified" json:"verified" query:"verified"`
+	Sha256         string `thrift:"sha256,4" form:"sha256" json:"sha256" query:"sha256"`
+	AttestedSha256 string `thrift:"attested_sha256,5" form:"attested_sha256" json:"attested_sha256" query:"attested_sha256"`
+	ManifestRoot   string `thrift:"manifest_root,6" form:"manifest_root" json:"manifest_root" query:"manifest_root"`
+	Reason         string `thrift:"reason,7" form:"reason" json:"reason" query:"reason"`
+}
+
+func NewVerifyJobOutputResponse() *VerifyJobOutputResponse {
+	return &VerifyJobOutputResponse{}
+}
+
+func (p *VerifyJobOutputResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *VerifyJobOutputResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *VerifyJobOutputResponse) GetVerified() (v bool) {
+	return p.Verified
+}
+
+func (p *VerifyJobOutputResponse) GetSha256() (v string) {
+	return p.Sha256
+}
+
+func (p *VerifyJobOutputResponse) GetAttestedSha256() (v string) {
+	return p.AttestedSha256
+}
+
+func (p *VerifyJobOutputResponse) GetManifestRoot() (v string) {
+	return p.ManifestRoot
+}
+
+func (p *VerifyJobOutputResponse) GetReason() (v string) {
+	return p.Reason
+}
+
+var fieldIDToName_VerifyJobOutputResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "verified",
+	4: "sha256",
+	5: "attested_sha256",
+	6: "manifest_root",
+	7: "reason",
+}
+
+func (p *VerifyJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerifyJobOutputResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VerifyJobOutputResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *VerifyJobOutputResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *VerifyJobOutputResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Verified = _field
+	return nil
+}
+func (p *VerifyJobOutputResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Sha256 = _field
+	return nil
+}
+func (p *VerifyJobOutputResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AttestedSha256 = _field
+	return nil
+}
+func (p *VerifyJobOutputResponse) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ManifestRoot = _field
+	return nil
+}
+func (p *VerifyJobOutputResponse) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *VerifyJobOutputResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VerifyJobOutputResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VerifyJobOutputResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VerifyJobOutputResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *VerifyJobOutputResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("verified", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Verified); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *VerifyJobOutputResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sha256", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Sha256); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *VerifyJobOutputResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attested_sha256", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AttestedSha256); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *VerifyJobOutputResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("manifest_root", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ManifestRoot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *VerifyJobOutputResponse) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *VerifyJobOutputResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyJobOutputResponse(%+v)", *p)
+
+}
+
+type QueryJobAttestationRequest struct {
+	ID      int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
+	Creator string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+}
+
+func NewQueryJobAttestationRequest() *QueryJobAttestationRequest {
+	return &QueryJobAttestationRequest{}
+}
+
+func (p *QueryJobAttestationRequest) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *QueryJobAttestationRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+var fieldIDToName_QueryJobAttestationRequest = map[int16]string{
+	1: "id",
+	2: "creator",
+}
+
+func (p *QueryJobAttestationRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobAttestationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryJobAttestationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *QueryJobAttestationRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+
+func (p *QueryJobAttestationRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobAttestationRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobAttestationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobAttestationRequest(%+v)", *p)
+
+}
+
+type QueryJobAttestationResponse struct {
+	Code  int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg   string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Token string `thrift:"token,3" form:"token" json:"token" query:"token"`
+}
+
+func NewQueryJobAttestationResponse() *QueryJobAttestationResponse {
+	return &QueryJobAttestationResponse{}
+}
+
+func (p *QueryJobAttestationResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *QueryJobAttestationResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *QueryJobAttestationResponse) GetToken() (v string) {
+	return p.Token
+}
+
+var fieldIDToName_QueryJobAttestationResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "token",
+}
+
+func (p *QueryJobAttestationResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobAttestationResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *QueryJobAttestationResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *QueryJobAttestationResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Token = _field
+	return nil
+}
+
+func (p *QueryJobAttestationResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobAttestationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("token", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Token); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobAttestationResponse(%+v)", *p)
+
+}
+
+type VerifyJobAttestationRequest struct {
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
+	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewVerifyJobAttestationRequest() *VerifyJobAttestationRequest {
+	return &VerifyJobAttestationRequest{}
+}
+
+func (p *VerifyJobAttestationRequest) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *VerifyJobAttestationRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *VerifyJobAttestationRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_VerifyJobAttestationRequest = map[int16]string{
+	1:   "id",
+	2:   "creator",
+	255: "access_token",
+}
+
+func (p *VerifyJobAttestationRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerifyJobAttestationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_VerifyJobAttestationRequest[fieldId]))
+}
+
+func (p *VerifyJobAttestationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *VerifyJobAttestationRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *VerifyJobAttestationRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *VerifyJobAttestationRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VerifyJobAttestationRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyJobAttestationRequest(%+v)", *p)
+
+}
+
+type VerifyJobAttestationResponse struct {
+	Code       int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg        string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Verified   bool   `thrift:"verified,3" form:"verified" json:"verified" query:"verified"`
+	Reason     string `thrift:"reason,4" form:"reason" json:"reason" query:"reason"`
+	Nonce      string `thrift:"nonce,5" form:"nonce" json:"nonce" query:"nonce"`
+	Commitment string `thrift:"commitment,6" form:"commitment" json:"commitment" query:"commitment"`
+}
+
+func NewVerifyJobAttestationResponse() *VerifyJobAttestationResponse {
+	return &VerifyJobAttestationResponse{}
+}
+
+func (p *VerifyJobAttestationResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *VerifyJobAttestationResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *VerifyJobAttestationResponse) GetVerified() (v bool) {
+	return p.Verified
+}
+
+func (p *VerifyJobAttestationResponse) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *VerifyJobAttestationResponse) GetNonce() (v string) {
+	return p.Nonce
+}
+
+func (p *VerifyJobAttestationResponse) GetCommitment() (v string) {
+	return p.Commitment
+}
+
+var fieldIDToName_VerifyJobAttestationResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "verified",
+	4: "reason",
+	5: "nonce",
+	6: "commitment",
+}
+
+func (p *VerifyJobAttestationResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerifyJobAttestationResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *VerifyJobAttestationResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *VerifyJobAttestationResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Verified = _field
+	return nil
+}
+func (p *VerifyJobAttestationResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+func (p *VerifyJobAttestationResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Nonce = _field
+	return nil
+}
+func (p *VerifyJobAttestationResponse) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Commitment = _field
+	return nil
+}
+
+func (p *VerifyJobAttestationResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VerifyJobAttestationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("verified", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Verified); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("nonce", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Nonce); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("commitment", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Commitment); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyJobAttestationResponse(%+v)", *p)
+
+}
+
+type QueryJobProvenanceRequest struct {
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
+	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewQueryJobProvenanceRequest() *QueryJobProvenanceRequest {
+	return &QueryJobProvenanceRequest{}
+}
+
+func (p *QueryJobProvenanceRequest) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *QueryJobProvenanceRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *QueryJobProvenanceRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_QueryJobProvenanceRequest = map[int16]string{
+	1:   "id",
+	2:   "creator",
+	255: "access_token",
+}
+
+func (p *QueryJobProvenanceRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobProvenanceRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryJobProvenanceRequest[fieldId]))
+}
+
+func (p *QueryJobProvenanceRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *QueryJobProvenanceRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *QueryJobProvenanceRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *QueryJobProvenanceRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobProvenanceRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobProvenanceRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobProvenanceRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobProvenanceRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryJobProvenanceRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobProvenanceRequest(%+v)", *p)
+
+}
+
+type QueryJobProvenanceResponse struct {
+	Code       int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg        string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Sbom       string `thrift:"sbom,3" form:"sbom" json:"sbom" query:"sbom"`
+	Provenance string `thrift:"provenance,4" form:"provenance" json:"provenance" query:"provenance"`
+}
+
+func NewQueryJobProvenanceResponse() *QueryJobProvenanceResponse {
+	return &QueryJobProvenanceResponse{}
+}
+
+func (p *QueryJobProvenanceResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *QueryJobProvenanceResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *QueryJobProvenanceResponse) GetSbom() (v string) {
+	return p.Sbom
+}
+
+func (p *QueryJobProvenanceResponse) GetProvenance() (v string) {
+	return p.Provenance
+}
+
+var fieldIDToName_QueryJobProvenanceResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "sbom",
+	4: "provenance",
+}
+
+func (p *QueryJobProvenanceResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobProvenanceResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryJobProvenanceResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *QueryJobProvenanceResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *QueryJobProvenanceResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Sbom = _field
+	return nil
+}
+func (p *QueryJobProvenanceResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Provenance = _field
+	return nil
+}
+
+func (p *QueryJobProvenanceResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobProvenanceResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobProvenanceResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobProvenanceResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobProvenanceResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sbom", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Sbom); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryJobProvenanceResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("provenance", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Provenance); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *QueryJobProvenanceResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobProvenanceResponse(%+v)", *p)
+
+}
+
+type JobAttempt struct {
+	Attempt      int32  `thrift:"attempt,1" form:"attempt" json:"attempt" query:"attempt"`
+	InstanceName string `thrift:"instance_name,2" form:"instance_name" json:"instance_name" query:"instance_name"`
+	Spot         bool   `thrift:"spot,3" form:"spot" json:"spot" query:"spot"`
+	Outcome      string `thrift:"outcome,4" form:"outcome" json:"outcome" query:"outcome"`
+	Reason       string `thrift:"reason,5" form:"reason" json:"reason" query:"reason"`
+	StartedAt    string `thrift:"started_at,6" form:"started_at" json:"started_at" query:"started_at"`
+	EndedAt      string `thrift:"ended_at,7" form:"ended_at" json:"ended_at" query:"ended_at"`
+	Zone         string `thrift:"zone,8" form:"zone" json:"zone" query:"zone"`
+}
+
+func NewJobAttempt() *JobAttempt {
+	return &JobAttempt{}
+}
+
+func (p *JobAttempt) GetAttempt() (v int32) {
+	return p.Attempt
+}
+
+func (p *JobAttempt) GetInstanceName() (v string) {
+	return p.InstanceName
+}
+
+func (p *JobAttempt) GetSpot() (v bool) {
+	return p.Spot
+}
+
+func (p *JobAttempt) GetOutcome() (v string) {
+	return p.Outcome
+}
+
+func (p *JobAttempt) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *JobAttempt) GetStartedAt() (v string) {
+	return p.StartedAt
+}
+
+func (p *JobAttempt) GetEndedAt() (v string) {
+	return p.EndedAt
+}
+
+func (p *JobAttempt) GetZone() (v string) {
+	return p.Zone
+}
+
+var fieldIDToName_JobAttempt = map[int16]string{
+	1: "attempt",
+	2: "instance_name",
+	3: "spot",
+	4: "outcome",
+	5: "reason",
+	6: "started_at",
+	7: "ended_at",
+	8: "zone",
+}
+
+func (p *JobAttempt) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobAttempt[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobAttempt) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Attempt = _field
+	return nil
+}
+func (p *JobAttempt) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.InstanceName = _field
+	return nil
+}
+func (p *JobAttempt) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Spot = _field
+	return nil
+}
+func (p *JobAttempt) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Outcome = _field
+	return nil
+}
+func (p *JobAttempt) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+func (p *JobAttempt) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StartedAt = _field
+	return nil
+}
+func (p *JobAttempt) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EndedAt = _field
+	return nil
+}
+func (p *JobAttempt) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Zone = _field
+	return nil
+}
+
+func (p *JobAttempt) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("JobAttempt"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobAttempt) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attempt", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Attempt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobAttempt) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("instance_name", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.InstanceName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *JobAttempt) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("spot", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Spot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *JobAttempt) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("outcome", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Outcome); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *JobAttempt) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *JobAttempt) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("started_at", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StartedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *JobAttempt) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("ended_at", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.EndedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *JobAttempt) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("zone", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Zone); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *JobAttempt) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobAttempt(%+v)", *p)
+
+}
+
+type QueryJobAttemptsRequest struct {
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
+	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewQueryJobAttemptsRequest() *QueryJobAttemptsRequest {
+	return &QueryJobAttemptsRequest{}
+}
+
+func (p *QueryJobAttemptsRequest) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *QueryJobAttemptsRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *QueryJobAttemptsRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_QueryJobAttemptsRequest = map[int16]string{
+	1:   "id",
+	2:   "creator",
+	255: "access_token",
+}
+
+func (p *QueryJobAttemptsRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobAttemptsRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryJobAttemptsRequest[fieldId]))
+}
+
+func (p *QueryJobAttemptsRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *QueryJobAttemptsRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *QueryJobAttemptsRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *QueryJobAttemptsRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobAttemptsRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobAttemptsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobAttemptsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobAttemptsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryJobAttemptsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobAttemptsRequest(%+v)", *p)
+
+}
+
+type QueryJobAttemptsResponse struct {
+	Code     int32         `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg      string        `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Attempts []*JobAttempt `thrift:"attempts,3" form:"attempts" json:"attempts" query:"attempts"`
+}
+
+func NewQueryJobAttemptsResponse() *QueryJobAttemptsResponse {
+	return &QueryJobAttemptsResponse{}
+}
+
+func (p *QueryJobAttemptsResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *QueryJobAttemptsResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *QueryJobAttemptsResponse) GetAttempts() (v []*JobAttempt) {
+	return p.Attempts
+}
+
+var fieldIDToName_QueryJobAttemptsResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "attempts",
+}
+
+func (p *QueryJobAttemptsResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobAttemptsResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryJobAttemptsResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *QueryJobAttemptsResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *QueryJobAttemptsResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*JobAttempt, 0, size)
+	values := make([]JobAttempt, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Attempts = _field
+	return nil
+}
+
+func (p *QueryJobAttemptsResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobAttemptsResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobAttemptsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobAttemptsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobAttemptsResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attempts", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Attempts)); err != nil {
+		return err
+	}
+	for _, v := range p.Attempts {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryJobAttemptsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobAttemptsResponse(%+v)", *p)
+
+}
+
+type QueryJobStateRequest struct {
+	UUID        string `thrift:"uuid,1" form:"uuid" json:"uuid" query:"uuid" vd:"len($) > 0 && len($) < 64"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewQueryJobStateRequest() *QueryJobStateRequest {
+	return &QueryJobStateRequest{}
+}
+
+func (p *QueryJobStateRequest) GetUUID() (v string) {
+	return p.UUID
+}
+
+func (p *QueryJobStateRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_QueryJobStateRequest = map[int16]string{
+	1:   "uuid",
+	255: "access_token",
+}
+
+func (p *QueryJobStateRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobStateRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryJobStateRequest[fieldId]))
+}
+
+func (p *QueryJobStateRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UUID = _field
+	return nil
+}
+func (p *QueryJobStateRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *QueryJobStateRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobStateRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobStateRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UUID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobStateRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryJobStateRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobStateRequest(%+v)", *p)
+
+}
+
+type QueryJobStateResponse struct {
+	Code         int32     `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg          string    `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Creator      string    `thrift:"creator,3" form:"creator" json:"creator" query:"creator"`
+	JobStatus    JobStatus `thrift:"job_status,4" form:"job_status" json:"job_status" query:"job_status"`
+	InstanceName string    `thrift:"instance_name,5" form:"instance_name" json:"instance_name" query:"instance_name"`
+	Ended        bool      `thrift:"ended,6" form:"ended" json:"ended" query:"ended"`
+}
+
+func NewQueryJobStateResponse() *QueryJobStateResponse {
+	return &QueryJobStateResponse{}
+}
+
+func (p *QueryJobStateResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *QueryJobStateResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *QueryJobStateResponse) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *QueryJobStateResponse) GetJobStatus() (v JobStatus) {
+	return p.JobStatus
+}
+
+func (p *QueryJobStateResponse) GetInstanceName() (v string) {
+	return p.InstanceName
+}
+
+func (p *QueryJobStateResponse) GetEnded() (v bool) {
+	return p.Ended
+}
+
+var fieldIDToName_QueryJobStateResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "creator",
+	4: "job_status",
+	5: "instance_name",
+	6: "ended",
+}
+
+func (p *QueryJobStateResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobStateResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryJobStateResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *QueryJobStateResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *QueryJobStateResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *QueryJobStateResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field JobStatus
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = JobStatus(v)
+	}
+	p.JobStatus = _field
+	return nil
+}
+func (p *QueryJobStateResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.InstanceName = _field
+	return nil
+}
+func (p *QueryJobStateResponse) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Ended = _field
+	return nil
+}
+
+func (p *QueryJobStateResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobStateResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobStateResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobStateResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobStateResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryJobStateResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_status", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.JobStatus)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *QueryJobStateResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("instance_name", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.InstanceName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *QueryJobStateResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("ended", thrift.BOOL, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Ended); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *QueryJobStateResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobStateResponse(%+v)", *p)
+
+}
+
+type JobHandler interface {
+	SubmitJob(ctx context.Context, req *SubmitJobRequest) (r *SubmitJobResponse, err error)
+
+	QueryJob(ctx context.Context, req *QueryJobRequest) (r *QueryJobResponse, err error)
+
+	DeleteJob(ctx context.Context, req *DeleteJobRequest) (r *DeleteJobResponse, err error)
+
+	CancelJob(ctx context.Context, req *CancelJobRequest) (r *CancelJobResponse, err error)
+
+	UpdateJobStatus(ctx context.Context, req *UpdateJobStatusRequest) (r *UpdateJobStatusResponse, err error)
+
+	QueryJobOutputAttr(ctx context.Context, req *QueryJobOutputRequest) (r *QueryJobOutputResponse, err error)
+
+	DownloadJobOutput(ctx context.Context, req *DownloadJobOutputRequest) (r *DownloadJobOutputResponse, err error)
+
+	ListJobOutputs(ctx context.Context, req *ListJobOutputsRequest) (r *ListJobOutputsResponse, err error)
+
+	VerifyJobOutput(ctx context.Context, req *VerifyJobOutputRequest) (r *VerifyJobOutputResponse, err error)
+
+	QueryJobAttestationReport(ctx context.Context, req *QueryJobAttestationRequest) (r *QueryJobAttestationResponse, err error)
+
+	VerifyJobAttestation(ctx context.Context, req *VerifyJobAttestationRequest) (r *VerifyJobAttestationResponse, err error)
+
+	QueryJobProvenance(ctx context.Context, req *QueryJobProvenanceRequest) (r *QueryJobProvenanceResponse, err error)
+
+	QueryJobAttempts(ctx context.Context, req *QueryJobAttemptsRequest) (r *QueryJobAttemptsResponse, err error)
+
+	QueryJobState(ctx context.Context, req *QueryJobStateRequest) (r *QueryJobStateResponse, err error)
+}
+
+type JobHandlerClient struct {
+	c thrift.TClient
+}
+
+func NewJobHandlerClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *JobHandlerClient {
+	return &JobHandlerClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewJobHandlerClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *JobHandlerClient {
+	return &JobHandlerClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewJobHandlerClient(c thrift.TClient) *JobHandlerClient {
+	return &JobHandlerClient{
+		c: c,
+	}
+}
+
+func (p *JobHandlerClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *JobHandlerClient) SubmitJob(ctx context.Context, req *SubmitJobRequest) (r *SubmitJobResponse, err error) {
+	var _args JobHandlerSubmitJobArgs
+	_args.Req = req
+	var _result JobHandlerSubmitJobResult
+	if err = p.Client_().Call(ctx, "SubmitJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryJob(ctx context.Context, req *QueryJobRequest) (r *QueryJobResponse, err error) {
+	var _args JobHandlerQueryJobArgs
+	_args.Req = req
+	var _result JobHandlerQueryJobResult
+	if err = p.Client_().Call(ctx, "QueryJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) DeleteJob(ctx context.Context, req *DeleteJobRequest) (r *DeleteJobResponse, err error) {
+	var _args JobHandlerDeleteJobArgs
+	_args.Req = req
+	var _result JobHandlerDeleteJobResult
+	if err = p.Client_().Call(ctx, "DeleteJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) CancelJob(ctx context.Context, req *CancelJobRequest) (r *CancelJobResponse, err error) {
+	var _args JobHandlerCancelJobArgs
+	_args.Req = req
+	var _result JobHandlerCancelJobResult
+	if err = p.Client_().Call(ctx, "CancelJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) UpdateJobStatus(ctx context.Context, req *UpdateJobStatusRequest) (r *UpdateJobStatusResponse, err error) {
+	var _args JobHandlerUpdateJobStatusArgs
+	_args.Req = req
+	var _result JobHandlerUpdateJobStatusResult
+	if err = p.Client_().Call(ctx, "UpdateJobStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryJobOutputAttr(ctx context.Context, req *QueryJobOutputRequest) (r *QueryJobOutputResponse, err error) {
+	var _args JobHandlerQueryJobOutputAttrArgs
+	_args.Req = req
+	var _result JobHandlerQueryJobOutputAttrResult
+	if err = p.Client_().Call(ctx, "QueryJobOutputAttr", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) DownloadJobOutput(ctx context.Context, req *DownloadJobOutputRequest) (r *DownloadJobOutputResponse, err error) {
+	var _args JobHandlerDownloadJobOutputArgs
+	_args.Req = req
+	var _result JobHandlerDownloadJobOutputResult
+	if err = p.Client_().Call(ctx, "DownloadJobOutput", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) ListJobOutputs(ctx context.Context, req *ListJobOutputsRequest) (r *ListJobOutputsResponse, err error) {
+	var _args JobHandlerListJobOutputsArgs
+	_args.Req = req
+	var _result JobHandlerListJobOutputsResult
+	if err = p.Client_().Call(ctx, "ListJobOutputs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) VerifyJobOutput(ctx context.Context, req *VerifyJobOutputRequest) (r *VerifyJobOutputResponse, err error) {
+	var _args JobHandlerVerifyJobOutputArgs
+	_args.Req = req
+	var _result JobHandlerVerifyJobOutputResult
+	if err = p.Client_().Call(ctx, "VerifyJobOutput", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryJobAttestationReport(ctx context.Context, req *QueryJobAttestationRequest) (r *QueryJobAttestationResponse, err error) {
+	var _args JobHandlerQueryJobAttestationReportArgs
+	_args.Req = req
+	var _result JobHandlerQueryJobAttestationReportResult
+	if err = p.Client_().Call(ctx, "QueryJobAttestationReport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) VerifyJobAttestation(ctx context.Context, req *VerifyJobAttestationRequest) (r *VerifyJobAttestationResponse, err error) {
+	var _args JobHandlerVerifyJobAttestationArgs
+	_args.Req = req
+	var _result JobHandlerVerifyJobAttestationResult
+	if err = p.Client_().Call(ctx, "VerifyJobAttestation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryJobProvenance(ctx context.Context, req *QueryJobProvenanceRequest) (r *QueryJobProvenanceResponse, err error) {
+	var _args JobHandlerQueryJobProvenanceArgs
+	_args.Req = req
+	var _result JobHandlerQueryJobProvenanceResult
+	if err = p.Client_().Call(ctx, "QueryJobProvenance", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryJobAttempts(ctx context.Context, req *QueryJobAttemptsRequest) (r *QueryJobAttemptsResponse, err error) {
+	var _args JobHandlerQueryJobAttemptsArgs
+	_args.Req = req
+	var _result JobHandlerQueryJobAttemptsResult
+	if err = p.Client_().Call(ctx, "QueryJobAttempts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryJobState(ctx context.Context, req *QueryJobStateRequest) (r *QueryJobStateResponse, err error) {
+	var _args JobHandlerQueryJobStateArgs
+	_args.Req = req
+	var _result JobHandlerQueryJobStateResult
+	if err = p.Client_().Call(ctx, "QueryJobState", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type JobHandlerProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      JobHandler
+}
+
+func (p *JobHandlerProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *JobHandlerProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *JobHandlerProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewJobHandlerProcessor(handler JobHandler) *JobHandlerProcessor {
+	self := &JobHandlerProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("SubmitJob", &jobHandlerProcessorSubmitJob{handler: handler})
+	self.AddToProcessorMap("QueryJob", &jobHandlerProcessorQueryJob{handler: handler})
+	self.AddToProcessorMap("DeleteJob", &jobHandlerProcessorDeleteJob{handler: handler})
+	self.AddToProcessorMap("CancelJob", &jobHandlerProcessorCancelJob{handler: handler})
+	self.AddToProcessorMap("UpdateJobStatus", &jobHandlerProcessorUpdateJobStatus{handler: handler})
+	self.AddToProcessorMap("QueryJobOutputAttr", &jobHandlerProcessorQueryJobOutputAttr{handler: handler})
+	self.AddToProcessorMap("DownloadJobOutput", &jobHandlerProcessorDownloadJobOutput{handler: handler})
+	self.AddToProcessorMap("ListJobOutputs", &jobHandlerProcessorListJobOutputs{handler: handler})
+	self.AddToProcessorMap("VerifyJobOutput", &jobHandlerProcessorVerifyJobOutput{handler: handler})
+	self.AddToProcessorMap("QueryJobAttestationReport", &jobHandlerProcessorQueryJobAttestationReport{handler: handler})
+	self.AddToProcessorMap("VerifyJobAttestation", &jobHandlerProcessorVerifyJobAttestation{handler: handler})
+	self.AddToProcessorMap("QueryJobProvenance", &jobHandlerProcessorQueryJobProvenance{handler: handler})
+	self.AddToProcessorMap("QueryJobAttempts", &jobHandlerProcessorQueryJobAttempts{handler: handler})
+	self.AddToProcessorMap("QueryJobState", &jobHandlerProcessorQueryJobState{handler: handler})
+	return self
+}
+func (p *JobHandlerProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type jobHandlerProcessorSubmitJob struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorSubmitJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerSubmitJobArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SubmitJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerSubmitJobResult{}
+	var retval *SubmitJobResponse
+	if retval, err2 = p.handler.SubmitJob(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SubmitJob: "+err2.Error())
+		oprot.WriteMessageBegin("SubmitJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SubmitJob", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryJob struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryJobArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryJobResult{}
+	var retval *QueryJobResponse
+	if retval, err2 = p.handler.QueryJob(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJob: "+err2.Error())
+		oprot.WriteMessageBegin("QueryJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryJob", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorDeleteJob struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorDeleteJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerDeleteJobArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerDeleteJobResult{}
+	var retval *DeleteJobResponse
+	if retval, err2 = p.handler.DeleteJob(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteJob: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteJob", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorCancelJob struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorCancelJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerCancelJobArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CancelJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerCancelJobResult{}
+	var retval *CancelJobResponse
+	if retval, err2 = p.handler.CancelJob(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CancelJob: "+err2.Error())
+		oprot.WriteMessageBegin("CancelJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CancelJob", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorUpdateJobStatus struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorUpdateJobStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerUpdateJobStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateJobStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerUpdateJobStatusResult{}
+	var retval *UpdateJobStatusResponse
+	if retval, err2 = p.handler.UpdateJobStatus(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateJobStatus: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateJobStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateJobStatus", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryJobOutputAttr struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryJobOutputAttr) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryJobOutputAttrArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryJobOutputAttr", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryJobOutputAttrResult{}
+	var retval *QueryJobOutputResponse
+	if retval, err2 = p.handler.QueryJobOutputAttr(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobOutputAttr: "+err2.Error())
+		oprot.WriteMessageBegin("QueryJobOutputAttr", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryJobOutputAttr", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorDownloadJobOutput struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorDownloadJobOutput) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerDownloadJobOutputArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DownloadJobOutput", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerDownloadJobOutputResult{}
+	var retval *DownloadJobOutputResponse
+	if retval, err2 = p.handler.DownloadJobOutput(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DownloadJobOutput: "+err2.Error())
+		oprot.WriteMessageBegin("DownloadJobOutput", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DownloadJobOutput", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorListJobOutputs struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorListJobOutputs) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerListJobOutputsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListJobOutputs", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerListJobOutputsResult{}
+	var retval *ListJobOutputsResponse
+	if retval, err2 = p.handler.ListJobOutputs(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListJobOutputs: "+err2.Error())
+		oprot.WriteMessageBegin("ListJobOutputs", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListJobOutputs", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorVerifyJobOutput struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorVerifyJobOutput) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerVerifyJobOutputArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("VerifyJobOutput", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerVerifyJobOutputResult{}
+	var retval *VerifyJobOutputResponse
+	if retval, err2 = p.handler.VerifyJobOutput(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VerifyJobOutput: "+err2.Error())
+		oprot.WriteMessageBegin("VerifyJobOutput", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("VerifyJobOutput", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryJobAttestationReport struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryJobAttestationReport) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryJobAttestationReportArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryJobAttestationReportResult{}
+	var retval *QueryJobAttestationResponse
+	if retval, err2 = p.handler.QueryJobAttestationReport(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobAttestationReport: "+err2.Error())
+		oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorVerifyJobAttestation struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorVerifyJobAttestation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerVerifyJobAttestationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("VerifyJobAttestation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerVerifyJobAttestationResult{}
+	var retval *VerifyJobAttestationResponse
+	if retval, err2 = p.handler.VerifyJobAttestation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VerifyJobAttestation: "+err2.Error())
+		oprot.WriteMessageBegin("VerifyJobAttestation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("VerifyJobAttestation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryJobProvenance struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryJobProvenance) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryJobProvenanceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryJobProvenance", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryJobProvenanceResult{}
+	var retval *QueryJobProvenanceResponse
+	if retval, err2 = p.handler.QueryJobProvenance(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobProvenance: "+err2.Error())
+		oprot.WriteMessageBegin("QueryJobProvenance", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryJobProvenance", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryJobAttempts struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryJobAttempts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryJobAttemptsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryJobAttempts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryJobAttemptsResult{}
+	var retval *QueryJobAttemptsResponse
+	if retval, err2 = p.handler.QueryJobAttempts(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobAttempts: "+err2.Error())
+		oprot.WriteMessageBegin("QueryJobAttempts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryJobAttempts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryJobState struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryJobState) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryJobStateArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryJobState", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryJobStateResult{}
+	var retval *QueryJobStateResponse
+	if retval, err2 = p.handler.QueryJobState(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobState: "+err2.Error())
+		oprot.WriteMessageBegin("QueryJobState", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryJobState", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type JobHandlerSubmitJobArgs struct {
+	Req *SubmitJobRequest `thrift:"req,1"`
+}
+
+func NewJobHandlerSubmitJobArgs() *JobHandlerSubmitJobArgs {
+	return &JobHandlerSubmitJobArgs{}
+}
+
+var JobHandlerSubmitJobArgs_Req_DEFAULT *SubmitJobRequest
+
+func (p *JobHandlerSubmitJobArgs) GetReq() (v *SubmitJobRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerSubmitJobArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerSubmitJobArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerSubmitJobArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerSubmitJobArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerSubmitJobArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSubmitJobRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerSubmitJobArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitJob_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerSubmitJobArgs(%+v)", *p)
+
+}
+
+type JobHandlerSubmitJobResult struct {
+	Success *SubmitJobResponse `thrift:"success,0,optional"`
+}
+
+func NewJobHandlerSubmitJobResult() *JobHandlerSubmitJobResult {
+	return &JobHandlerSubmitJobResult{}
+}
+
+var JobHandlerSubmitJobResult_Success_DEFAULT *SubmitJobResponse
+
+func (p *JobHandlerSubmitJobResult) GetSuccess() (v *SubmitJobResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerSubmitJobResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerSubmitJobResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerSubmitJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerSubmitJobResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerSubmitJobResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitJobResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *JobHandlerSubmitJobResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitJob_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerSubmitJobResult(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobArgs struct {
+	Req *QueryJobRequest `thrift:"req,1"`
+}
+
+func NewJobHandlerQueryJobArgs() *JobHandlerQueryJobArgs {
+	return &JobHandlerQueryJobArgs{}
+}
+
+var JobHandlerQueryJobArgs_Req_DEFAULT *QueryJobRequest
+
+func (p *JobHandlerQueryJobArgs) GetReq() (v *QueryJobRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerQueryJobArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerQueryJobArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerQueryJobArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerQueryJobArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryJobRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerQueryJobArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJob_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerQueryJobArgs(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobResult struct {
+	Success *QueryJobResponse `thrift:"success,0,optional"`
+}
+
+func NewJobHandlerQueryJobResult() *JobHandlerQueryJobResult {
+	return &JobHandlerQueryJobResult{}
+}
+
+var JobHandlerQueryJobResult_Success_DEFAULT *QueryJobResponse
+
+func (p *JobHandlerQueryJobResult) GetSuccess() (v *QueryJobResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerQueryJobResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerQueryJobResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerQueryJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerQueryJobResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryJobResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *JobHandlerQueryJobResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJob_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerQueryJobResult(%+v)", *p)
+
+}
+
+type JobHandlerDeleteJobArgs struct {
+	Req *DeleteJobRequest `thrift:"req,1"`
+}
+
+func NewJobHandlerDeleteJobArgs() *JobHandlerDeleteJobArgs {
+	return &JobHandlerDeleteJobArgs{}
+}
+
+var JobHandlerDeleteJobArgs_Req_DEFAULT *DeleteJobRequest
+
+func (p *JobHandlerDeleteJobArgs) GetReq() (v *DeleteJobRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerDeleteJobArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerDeleteJobArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerDeleteJobArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerDeleteJobArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDeleteJobArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerDeleteJobArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteJobRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerDeleteJobArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteJob_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerDeleteJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerDeleteJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerDeleteJobArgs(%+v)", *p)
+
+}
+
+type JobHandlerDeleteJobResult struct {
+	Success *DeleteJobResponse `thrift:"success,0,optional"`
+}
+
+func NewJobHandlerDeleteJobResult() *JobHandlerDeleteJobResult {
+	return &JobHandlerDeleteJobResult{}
+}
+
+var JobHandlerDeleteJobResult_Success_DEFAULT *DeleteJobResponse
+
+func (p *JobHandlerDeleteJobResult) GetSuccess() (v *DeleteJobResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerDeleteJobResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerDeleteJobResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerDeleteJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerDeleteJobResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDeleteJobResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerDeleteJobResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteJobResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *JobHandlerDeleteJobResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteJob_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerDeleteJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerDeleteJobResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerDeleteJobResult(%+v)", *p)
+
+}
+
+type JobHandlerCancelJobArgs struct {
+	Req *CancelJobRequest `thrift:"req,1"`
+}
+
+func NewJobHandlerCancelJobArgs() *JobHandlerCancelJobArgs {
+	return &JobHandlerCancelJobArgs{}
+}
+
+var JobHandlerCancelJobArgs_Req_DEFAULT *CancelJobRequest
+
+func (p *JobHandlerCancelJobArgs) GetReq() (v *CancelJobRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerCancelJobArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerCancelJobArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerCancelJobArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerCancelJobArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerCancelJobArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerCancelJobArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCancelJobRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerCancelJobArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CancelJob_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerCancelJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerCancelJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerCancelJobArgs(%+v)", *p)
+
+}
+
+type JobHandlerCancelJobResult struct {
+	Success *CancelJobResponse `thrift:"success,0,optional"`
+}
+
+func NewJobHandlerCancelJobResult() *JobHandlerCancelJobResult {
+	return &JobHandlerCancelJobResult{}
+}
+
+var JobHandlerCancelJobResult_Success_DEFAULT *CancelJobResponse
+
+func (p *JobHandlerCancelJobResult) GetSuccess() (v *CancelJobResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerCancelJobResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerCancelJobResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerCancelJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerCancelJobResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerCancelJobResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerCancelJobResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCancelJobResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *JobHandlerCancelJobResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CancelJob_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerCancelJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerCancelJobResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerCancelJobResult(%+v)", *p)
+
+}
+
+type JobHandlerUpdateJobStatusArgs struct {
+	Req *UpdateJobStatusRequest `thrift:"req,1"`
+}
+
+func NewJobHandlerUpdateJobStatusArgs() *JobHandlerUpdateJobStatusArgs {
+	return &JobHandlerUpdateJobStatusArgs{}
+}
+
+var JobHandlerUpdateJobStatusArgs_Req_DEFAULT *UpdateJobStatusRequest
+
+func (p *JobHandlerUpdateJobStatusArgs) GetReq() (v *UpdateJobStatusRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerUpdateJobStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerUpdateJobStatusArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerUpdateJobStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerUpdateJobStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerUpdateJobStatusArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerUpdateJobStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateJobStatusRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerUpdateJobStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateJobStatus_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerUpdateJobStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerUpdateJobStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerUpdateJobStatusArgs(%+v)", *p)
+
+}
+
+type JobHandlerUpdateJobStatusResult struct {
+	Success *UpdateJobStatusResponse `thrift:"success,0,optional"`
+}
+
+func NewJobHandlerUpdateJobStatusResult() *JobHandlerUpdateJobStatusResult {
+	return &JobHandlerUpdateJobStatusResult{}
+}
+
+var JobHandlerUpdateJobStatusResult_Success_DEFAULT *UpdateJobStatusResponse
+
+func (p *JobHandlerUpdateJobStatusResult) GetSuccess() (v *UpdateJobStatusResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerUpdateJobStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerUpdateJobStatusResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerUpdateJobStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerUpdateJobStatusResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerUpdateJobStatusResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerUpdateJobStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateJobStatusResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *JobHandlerUpdateJobStatusResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateJobStatus_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerUpdateJobStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerUpdateJobStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerUpdateJobStatusResult(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobOutputAttrArgs struct {
+	Req *QueryJobOutputRequest `thrift:"req,1"`
+}
+
+func NewJobHandlerQueryJobOutputAttrArgs() *JobHandlerQueryJobOutputAttrArgs {
+	return &JobHandlerQueryJobOutputAttrArgs{}
+}
+
+var JobHandlerQueryJobOutputAttrArgs_Req_DEFAULT *QueryJobOutputRequest
+
+func (p *JobHandlerQueryJobOutputAttrArgs) GetReq() (v *QueryJobOutputRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerQueryJobOutputAttrArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerQueryJobOutputAttrArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerQueryJobOutputAttrArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerQueryJobOutputAttrArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobOutputAttrArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobOutputAttrArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryJobOutputRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerQueryJobOutputAttrArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobOutputAttr_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobOutputAttrArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobOutputAttrArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerQueryJobOutputAttrArgs(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobOutputAttrResult struct {
+	Success *QueryJobOutputResponse `thrift:"success,0,optional"`
+}
+
+func NewJobHandlerQueryJobOutputAttrResult() *JobHandlerQueryJobOutputAttrResult {
+	return &JobHandlerQueryJobOutputAttrResult{}
+}
+
+var JobHandlerQueryJobOutputAttrResult_Success_DEFAULT *QueryJobOutputResponse
+
+func (p *JobHandlerQueryJobOutputAttrResult) GetSuccess() (v *QueryJobOutputResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerQueryJobOutputAttrResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerQueryJobOutputAttrResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerQueryJobOutputAttrResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerQueryJobOutputAttrResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobOutputAttrResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobOutputAttrResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryJobOutputResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerDeleteJobResult{}
-	var retval *DeleteJobResponse
-	if retval, err2 = p.handler.DeleteJob(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteJob: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *JobHandlerQueryJobOutputAttrResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobOutputAttr_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("DeleteJob", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobOutputAttrResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobOutputAttrResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("JobHandlerQueryJobOutputAttrResult(%+v)", *p)
+
 }
 
-type jobHandlerProcessorUpdateJobStatus struct {
-	handler JobHandler
+type JobHandlerDownloadJobOutputArgs struct {
+	Req *DownloadJobOutputRequest `thrift:"req,1"`
 }
 
-func (p *jobHandlerProcessorUpdateJobStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerUpdateJobStatusArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateJobStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewJobHandlerDownloadJobOutputArgs() *JobHandlerDownloadJobOutputArgs {
+	return &JobHandlerDownloadJobOutputArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerUpdateJobStatusResult{}
-	var retval *UpdateJobStatusResponse
-	if retval, err2 = p.handler.UpdateJobStatus(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateJobStatus: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateJobStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateJobStatus", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+var JobHandlerDownloadJobOutputArgs_Req_DEFAULT *DownloadJobOutputRequest
+
+func (p *JobHandlerDownloadJobOutputArgs) GetReq() (v *DownloadJobOutputRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerDownloadJobOutputArgs_Req_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerDownloadJobOutputArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerDownloadJobOutputArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerDownloadJobOutputArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type jobHandlerProcessorQueryJobOutputAttr struct {
-	handler JobHandler
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDownloadJobOutputArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *jobHandlerProcessorQueryJobOutputAttr) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerQueryJobOutputAttrArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("QueryJobOutputAttr", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *JobHandlerDownloadJobOutputArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDownloadJobOutputRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerQueryJobOutputAttrResult{}
-	var retval *QueryJobOutputResponse
-	if retval, err2 = p.handler.QueryJobOutputAttr(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobOutputAttr: "+err2.Error())
-		oprot.WriteMessageBegin("QueryJobOutputAttr", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *JobHandlerDownloadJobOutputArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DownloadJobOutput_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("QueryJobOutputAttr", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerDownloadJobOutputArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerDownloadJobOutputArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("JobHandlerDownloadJobOutputArgs(%+v)", *p)
+
 }
 
-type jobHandlerProcessorDownloadJobOutput struct {
-	handler JobHandler
+type JobHandlerDownloadJobOutputResult struct {
+	Success *DownloadJobOutputResponse `thrift:"success,0,optional"`
 }
 
-func (p *jobHandlerProcessorDownloadJobOutput) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerDownloadJobOutputArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DownloadJobOutput", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewJobHandlerDownloadJobOutputResult() *JobHandlerDownloadJobOutputResult {
+	return &JobHandlerDownloadJobOutputResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerDownloadJobOutputResult{}
-	var retval *DownloadJobOutputResponse
-	if retval, err2 = p.handler.DownloadJobOutput(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DownloadJobOutput: "+err2.Error())
-		oprot.WriteMessageBegin("DownloadJobOutput", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DownloadJobOutput", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+var JobHandlerDownloadJobOutputResult_Success_DEFAULT *DownloadJobOutputResponse
+
+func (p *JobHandlerDownloadJobOutputResult) GetSuccess() (v *DownloadJobOutputResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerDownloadJobOutputResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerDownloadJobOutputResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerDownloadJobOutputResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerDownloadJobOutputResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type jobHandlerProcessorQueryJobAttestationReport struct {
-	handler JobHandler
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDownloadJobOutputResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *jobHandlerProcessorQueryJobAttestationReport) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerQueryJobAttestationReportArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *JobHandlerDownloadJobOutputResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDownloadJobOutputResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerQueryJobAttestationReportResult{}
-	var retval *QueryJobAttestationResponse
-	if retval, err2 = p.handler.QueryJobAttestationReport(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobAttestationReport: "+err2.Error())
-		oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *JobHandlerDownloadJobOutputResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DownloadJobOutput_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerDownloadJobOutputResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerDownloadJobOutputResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("JobHandlerDownloadJobOutputResult(%+v)", *p)
+
 }
 
-type JobHandlerSubmitJobArgs struct {
-	Req *SubmitJobRequest `thrift:"req,1"`
+type JobHandlerListJobOutputsArgs struct {
+	Req *ListJobOutputsRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerSubmitJobArgs() *JobHandlerSubmitJobArgs {
-	return &JobHandlerSubmitJobArgs{}
+func NewJobHandlerListJobOutputsArgs() *JobHandlerListJobOutputsArgs {
+	return &JobHandlerListJobOutputsArgs{}
 }
 
-var JobHandlerSubmitJobArgs_Req_DEFAULT *SubmitJobRequest
+var JobHandlerListJobOutputsArgs_Req_DEFAULT *ListJobOutputsRequest
 
-func (p *JobHandlerSubmitJobArgs) GetReq() (v *SubmitJobRequest) {
+func (p *JobHandlerListJobOutputsArgs) GetReq() (v *ListJobOutputsRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerSubmitJobArgs_Req_DEFAULT
+		return JobHandlerListJobOutputsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerSubmitJobArgs = map[int16]string{
+var fieldIDToName_JobHandlerListJobOutputsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerSubmitJobArgs) IsSetReq() bool {
+func (p *JobHandlerListJobOutputsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerSubmitJobArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerListJobOutputsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4604,7 +12702,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerSubmitJobArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerListJobOutputsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4614,8 +12712,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSubmitJobRequest()
+func (p *JobHandlerListJobOutputsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListJobOutputsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4623,9 +12721,9 @@ func (p *JobHandlerSubmitJobArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerSubmitJobArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerListJobOutputsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitJob_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListJobOutputs_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4651,7 +12749,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerListJobOutputsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4668,40 +12766,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobArgs) String() string {
+func (p *JobHandlerListJobOutputsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerSubmitJobArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerListJobOutputsArgs(%+v)", *p)
 
 }
 
-type JobHandlerSubmitJobResult struct {
-	Success *SubmitJobResponse `thrift:"success,0,optional"`
+type JobHandlerListJobOutputsResult struct {
+	Success *ListJobOutputsResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerSubmitJobResult() *JobHandlerSubmitJobResult {
-	return &JobHandlerSubmitJobResult{}
+func NewJobHandlerListJobOutputsResult() *JobHandlerListJobOutputsResult {
+	return &JobHandlerListJobOutputsResult{}
 }
 
-var JobHandlerSubmitJobResult_Success_DEFAULT *SubmitJobResponse
+var JobHandlerListJobOutputsResult_Success_DEFAULT *ListJobOutputsResponse
 
-func (p *JobHandlerSubmitJobResult) GetSuccess() (v *SubmitJobResponse) {
+func (p *JobHandlerListJobOutputsResult) GetSuccess() (v *ListJobOutputsResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerSubmitJobResult_Success_DEFAULT
+		return JobHandlerListJobOutputsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerSubmitJobResult = map[int16]string{
+var fieldIDToName_JobHandlerListJobOutputsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerSubmitJobResult) IsSetSuccess() bool {
+func (p *JobHandlerListJobOutputsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerSubmitJobResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerListJobOutputsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4747,7 +12845,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerSubmitJobResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerListJobOutputsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4757,8 +12855,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSubmitJobResponse()
+func (p *JobHandlerListJobOutputsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListJobOutputsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4766,9 +12864,9 @@ func (p *JobHandlerSubmitJobResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerSubmitJobResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerListJobOutputsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitJob_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListJobOutputs_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4794,7 +12892,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerListJobOutputsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4813,40 +12911,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobResult) String() string {
+func (p *JobHandlerListJobOutputsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerSubmitJobResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerListJobOutputsResult(%+v)", *p)
 
 }
 
-type JobHandlerQueryJobArgs struct {
-	Req *QueryJobRequest `thrift:"req,1"`
+type JobHandlerVerifyJobOutputArgs struct {
+	Req *VerifyJobOutputRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerQueryJobArgs() *JobHandlerQueryJobArgs {
-	return &JobHandlerQueryJobArgs{}
+func NewJobHandlerVerifyJobOutputArgs() *JobHandlerVerifyJobOutputArgs {
+	return &JobHandlerVerifyJobOutputArgs{}
 }
 
-var JobHandlerQueryJobArgs_Req_DEFAULT *QueryJobRequest
+var JobHandlerVerifyJobOutputArgs_Req_DEFAULT *VerifyJobOutputRequest
 
-func (p *JobHandlerQueryJobArgs) GetReq() (v *QueryJobRequest) {
+func (p *JobHandlerVerifyJobOutputArgs) GetReq() (v *VerifyJobOutputRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerQueryJobArgs_Req_DEFAULT
+		return JobHandlerVerifyJobOutputArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerQueryJobArgs = map[int16]string{
+var fieldIDToName_JobHandlerVerifyJobOutputArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerQueryJobArgs) IsSetReq() bool {
+func (p *JobHandlerVerifyJobOutputArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerQueryJobArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobOutputArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4892,7 +12990,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerVerifyJobOutputArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4902,8 +13000,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewQueryJobRequest()
+func (p *JobHandlerVerifyJobOutputArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewVerifyJobOutputRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4911,9 +13009,9 @@ func (p *JobHandlerQueryJobArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerQueryJobArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobOutputArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJob_args"); err != nil {
+	if err = oprot.WriteStructBegin("VerifyJobOutput_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4939,7 +13037,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobOutputArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4956,40 +13054,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobArgs) String() string {
+func (p *JobHandlerVerifyJobOutputArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerQueryJobArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerVerifyJobOutputArgs(%+v)", *p)
 
 }
 
-type JobHandlerQueryJobResult struct {
-	Success *QueryJobResponse `thrift:"success,0,optional"`
+type JobHandlerVerifyJobOutputResult struct {
+	Success *VerifyJobOutputResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerQueryJobResult() *JobHandlerQueryJobResult {
-	return &JobHandlerQueryJobResult{}
+func NewJobHandlerVerifyJobOutputResult() *JobHandlerVerifyJobOutputResult {
+	return &JobHandlerVerifyJobOutputResult{}
 }
 
-var JobHandlerQueryJobResult_Success_DEFAULT *QueryJobResponse
+var JobHandlerVerifyJobOutputResult_Success_DEFAULT *VerifyJobOutputResponse
 
-func (p *JobHandlerQueryJobResult) GetSuccess() (v *QueryJobResponse) {
+func (p *JobHandlerVerifyJobOutputResult) GetSuccess() (v *VerifyJobOutputResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerQueryJobResult_Success_DEFAULT
+		return JobHandlerVerifyJobOutputResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerQueryJobResult = map[int16]string{
+var fieldIDToName_JobHandlerVerifyJobOutputResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerQueryJobResult) IsSetSuccess() bool {
+func (p *JobHandlerVerifyJobOutputResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerQueryJobResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobOutputResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5035,7 +13133,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerVerifyJobOutputResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5045,8 +13143,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewQueryJobResponse()
+func (p *JobHandlerVerifyJobOutputResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewVerifyJobOutputResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5054,9 +13152,9 @@ func (p *JobHandlerQueryJobResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerQueryJobResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobOutputResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJob_result"); err != nil {
+	if err = oprot.WriteStructBegin("VerifyJobOutput_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5082,7 +13180,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobOutputResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5101,40 +13199,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobResult) String() string {
+func (p *JobHandlerVerifyJobOutputResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerQueryJobResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerVerifyJobOutputResult(%+v)", *p)
 
 }
 
-type JobHandlerDeleteJobArgs struct {
-	Req *DeleteJobRequest `thrift:"req,1"`
+type JobHandlerQueryJobAttestationReportArgs struct {
+	Req *QueryJobAttestationRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerDeleteJobArgs() *JobHandlerDeleteJobArgs {
-	return &JobHandlerDeleteJobArgs{}
+func NewJobHandlerQueryJobAttestationReportArgs() *JobHandlerQueryJobAttestationReportArgs {
+	return &JobHandlerQueryJobAttestationReportArgs{}
 }
 
-var JobHandlerDeleteJobArgs_Req_DEFAULT *DeleteJobRequest
+var JobHandlerQueryJobAttestationReportArgs_Req_DEFAULT *QueryJobAttestationRequest
 
-func (p *JobHandlerDeleteJobArgs) GetReq() (v *DeleteJobRequest) {
+func (p *JobHandlerQueryJobAttestationReportArgs) GetReq() (v *QueryJobAttestationRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerDeleteJobArgs_Req_DEFAULT
+		return JobHandlerQueryJobAttestationReportArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerDeleteJobArgs = map[int16]string{
+var fieldIDToName_JobHandlerQueryJobAttestationReportArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerDeleteJobArgs) IsSetReq() bool {
+func (p *JobHandlerQueryJobAttestationReportArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerDeleteJobArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5180,7 +13278,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDeleteJobArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobAttestationReportArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5190,8 +13288,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteJobRequest()
+func (p *JobHandlerQueryJobAttestationReportArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryJobAttestationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5199,9 +13297,9 @@ func (p *JobHandlerDeleteJobArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerDeleteJobArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteJob_args"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobAttestationReport_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5227,7 +13325,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5244,40 +13342,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobArgs) String() string {
+func (p *JobHandlerQueryJobAttestationReportArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerDeleteJobArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryJobAttestationReportArgs(%+v)", *p)
 
 }
 
-type JobHandlerDeleteJobResult struct {
-	Success *DeleteJobResponse `thrift:"success,0,optional"`
+type JobHandlerQueryJobAttestationReportResult struct {
+	Success *QueryJobAttestationResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerDeleteJobResult() *JobHandlerDeleteJobResult {
-	return &JobHandlerDeleteJobResult{}
+func NewJobHandlerQueryJobAttestationReportResult() *JobHandlerQueryJobAttestationReportResult {
+	return &JobHandlerQueryJobAttestationReportResult{}
 }
 
-var JobHandlerDeleteJobResult_Success_DEFAULT *DeleteJobResponse
+var JobHandlerQueryJobAttestationReportResult_Success_DEFAULT *QueryJobAttestationResponse
 
-func (p *JobHandlerDeleteJobResult) GetSuccess() (v *DeleteJobResponse) {
+func (p *JobHandlerQueryJobAttestationReportResult) GetSuccess() (v *QueryJobAttestationResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerDeleteJobResult_Success_DEFAULT
+		return JobHandlerQueryJobAttestationReportResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerDeleteJobResult = map[int16]string{
+var fieldIDToName_JobHandlerQueryJobAttestationReportResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerDeleteJobResult) IsSetSuccess() bool {
+func (p *JobHandlerQueryJobAttestationReportResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerDeleteJobResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5323,7 +13421,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDeleteJobResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobAttestationReportResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5333,8 +13431,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteJobResponse()
+func (p *JobHandlerQueryJobAttestationReportResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryJobAttestationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5342,9 +13440,9 @@ func (p *JobHandlerDeleteJobResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerDeleteJobResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteJob_result"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobAttestationReport_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5370,7 +13468,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5389,40 +13487,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobResult) String() string {
+func (p *JobHandlerQueryJobAttestationReportResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerDeleteJobResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryJobAttestationReportResult(%+v)", *p)
 
 }
 
-type JobHandlerUpdateJobStatusArgs struct {
-	Req *UpdateJobStatusRequest `thrift:"req,1"`
+type JobHandlerVerifyJobAttestationArgs struct {
+	Req *VerifyJobAttestationRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerUpdateJobStatusArgs() *JobHandlerUpdateJobStatusArgs {
-	return &JobHandlerUpdateJobStatusArgs{}
+func NewJobHandlerVerifyJobAttestationArgs() *JobHandlerVerifyJobAttestationArgs {
+	return &JobHandlerVerifyJobAttestationArgs{}
 }
 
-var JobHandlerUpdateJobStatusArgs_Req_DEFAULT *UpdateJobStatusRequest
+var JobHandlerVerifyJobAttestationArgs_Req_DEFAULT *VerifyJobAttestationRequest
 
-func (p *JobHandlerUpdateJobStatusArgs) GetReq() (v *UpdateJobStatusRequest) {
+func (p *JobHandlerVerifyJobAttestationArgs) GetReq() (v *VerifyJobAttestationRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerUpdateJobStatusArgs_Req_DEFAULT
+		return JobHandlerVerifyJobAttestationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerUpdateJobStatusArgs = map[int16]string{
+var fieldIDToName_JobHandlerVerifyJobAttestationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerUpdateJobStatusArgs) IsSetReq() bool {
+func (p *JobHandlerVerifyJobAttestationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerUpdateJobStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5468,7 +13566,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerUpdateJobStatusArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerVerifyJobAttestationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5478,8 +13576,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerUpdateJobStatusArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateJobStatusRequest()
+func (p *JobHandlerVerifyJobAttestationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewVerifyJobAttestationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5487,9 +13585,9 @@ func (p *JobHandlerUpdateJobStatusArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *JobHandlerUpdateJobStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateJobStatus_args"); err != nil {
+	if err = oprot.WriteStructBegin("VerifyJobAttestation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5515,7 +13613,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerUpdateJobStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5532,40 +13630,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerUpdateJobStatusArgs) String() string {
+func (p *JobHandlerVerifyJobAttestationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerUpdateJobStatusArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerVerifyJobAttestationArgs(%+v)", *p)
 
 }
 
-type JobHandlerUpdateJobStatusResult struct {
-	Success *UpdateJobStatusResponse `thrift:"success,0,optional"`
+type JobHandlerVerifyJobAttestationResult struct {
+	Success *VerifyJobAttestationResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerUpdateJobStatusResult() *JobHandlerUpdateJobStatusResult {
-	return &JobHandlerUpdateJobStatusResult{}
+func NewJobHandlerVerifyJobAttestationResult() *JobHandlerVerifyJobAttestationResult {
+	return &JobHandlerVerifyJobAttestationResult{}
 }
 
-var JobHandlerUpdateJobStatusResult_Success_DEFAULT *UpdateJobStatusResponse
+var JobHandlerVerifyJobAttestationResult_Success_DEFAULT *VerifyJobAttestationResponse
 
-func (p *JobHandlerUpdateJobStatusResult) GetSuccess() (v *UpdateJobStatusResponse) {
+func (p *JobHandlerVerifyJobAttestationResult) GetSuccess() (v *VerifyJobAttestationResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerUpdateJobStatusResult_Success_DEFAULT
+		return JobHandlerVerifyJobAttestationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerUpdateJobStatusResult = map[int16]string{
+var fieldIDToName_JobHandlerVerifyJobAttestationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerUpdateJobStatusResult) IsSetSuccess() bool {
+func (p *JobHandlerVerifyJobAttestationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerUpdateJobStatusResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5611,7 +13709,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerUpdateJobStatusResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerVerifyJobAttestationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5621,8 +13719,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerUpdateJobStatusResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateJobStatusResponse()
+func (p *JobHandlerVerifyJobAttestationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewVerifyJobAttestationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5630,9 +13728,9 @@ func (p *JobHandlerUpdateJobStatusResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *JobHandlerUpdateJobStatusResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateJobStatus_result"); err != nil {
+	if err = oprot.WriteStructBegin("VerifyJobAttestation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5658,7 +13756,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerUpdateJobStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5677,40 +13775,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerUpdateJobStatusResult) String() string {
+func (p *JobHandlerVerifyJobAttestationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerUpdateJobStatusResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerVerifyJobAttestationResult(%+v)", *p)
 
 }
 
-type JobHandlerQueryJobOutputAttrArgs struct {
-	Req *QueryJobOutputRequest `thrift:"req,1"`
+type JobHandlerQueryJobProvenanceArgs struct {
+	Req *QueryJobProvenanceRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerQueryJobOutputAttrArgs() *JobHandlerQueryJobOutputAttrArgs {
-	return &JobHandlerQueryJobOutputAttrArgs{}
+func NewJobHandlerQueryJobProvenanceArgs() *JobHandlerQueryJobProvenanceArgs {
+	return &JobHandlerQueryJobProvenanceArgs{}
 }
 
-var JobHandlerQueryJobOutputAttrArgs_Req_DEFAULT *QueryJobOutputRequest
+var JobHandlerQueryJobProvenanceArgs_Req_DEFAULT *QueryJobProvenanceRequest
 
-func (p *JobHandlerQueryJobOutputAttrArgs) GetReq() (v *QueryJobOutputRequest) {
+func (p *JobHandlerQueryJobProvenanceArgs) GetReq() (v *QueryJobProvenanceRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerQueryJobOutputAttrArgs_Req_DEFAULT
+		return JobHandlerQueryJobProvenanceArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerQueryJobOutputAttrArgs = map[int16]string{
+var fieldIDToName_JobHandlerQueryJobProvenanceArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerQueryJobOutputAttrArgs) IsSetReq() bool {
+func (p *JobHandlerQueryJobProvenanceArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerQueryJobOutputAttrArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobProvenanceArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5756,7 +13854,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobOutputAttrArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobProvenanceArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5766,8 +13864,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobOutputAttrArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewQueryJobOutputRequest()
+func (p *JobHandlerQueryJobProvenanceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryJobProvenanceRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5775,9 +13873,9 @@ func (p *JobHandlerQueryJobOutputAttrArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *JobHandlerQueryJobOutputAttrArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobProvenanceArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobOutputAttr_args"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobProvenance_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5803,7 +13901,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobOutputAttrArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobProvenanceArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5820,40 +13918,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobOutputAttrArgs) String() string {
+func (p *JobHandlerQueryJobProvenanceArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerQueryJobOutputAttrArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryJobProvenanceArgs(%+v)", *p)
 
 }
 
-type JobHandlerQueryJobOutputAttrResult struct {
-	Success *QueryJobOutputResponse `thrift:"success,0,optional"`
+type JobHandlerQueryJobProvenanceResult struct {
+	Success *QueryJobProvenanceResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerQueryJobOutputAttrResult() *JobHandlerQueryJobOutputAttrResult {
-	return &JobHandlerQueryJobOutputAttrResult{}
+func NewJobHandlerQueryJobProvenanceResult() *JobHandlerQueryJobProvenanceResult {
+	return &JobHandlerQueryJobProvenanceResult{}
 }
 
-var JobHandlerQueryJobOutputAttrResult_Success_DEFAULT *QueryJobOutputResponse
+var JobHandlerQueryJobProvenanceResult_Success_DEFAULT *QueryJobProvenanceResponse
 
-func (p *JobHandlerQueryJobOutputAttrResult) GetSuccess() (v *QueryJobOutputResponse) {
+func (p *JobHandlerQueryJobProvenanceResult) GetSuccess() (v *QueryJobProvenanceResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerQueryJobOutputAttrResult_Success_DEFAULT
+		return JobHandlerQueryJobProvenanceResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerQueryJobOutputAttrResult = map[int16]string{
+var fieldIDToName_JobHandlerQueryJobProvenanceResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerQueryJobOutputAttrResult) IsSetSuccess() bool {
+func (p *JobHandlerQueryJobProvenanceResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerQueryJobOutputAttrResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobProvenanceResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5899,7 +13997,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobOutputAttrResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobProvenanceResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5909,8 +14007,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobOutputAttrResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewQueryJobOutputResponse()
+func (p *JobHandlerQueryJobProvenanceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryJobProvenanceResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5918,9 +14016,9 @@ func (p *JobHandlerQueryJobOutputAttrResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *JobHandlerQueryJobOutputAttrResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobProvenanceResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobOutputAttr_result"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobProvenance_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5946,7 +14044,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobOutputAttrResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobProvenanceResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5965,40 +14063,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobOutputAttrResult) String() string {
+func (p *JobHandlerQueryJobProvenanceResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerQueryJobOutputAttrResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryJobProvenanceResult(%+v)", *p)
 
 }
 
-type JobHandlerDownloadJobOutputArgs struct {
-	Req *DownloadJobOutputRequest `thrift:"req,1"`
+type JobHandlerQueryJobAttemptsArgs struct {
+	Req *QueryJobAttemptsRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerDownloadJobOutputArgs() *JobHandlerDownloadJobOutputArgs {
-	return &JobHandlerDownloadJobOutputArgs{}
+func NewJobHandlerQueryJobAttemptsArgs() *JobHandlerQueryJobAttemptsArgs {
+	return &JobHandlerQueryJobAttemptsArgs{}
 }
 
-var JobHandlerDownloadJobOutputArgs_Req_DEFAULT *DownloadJobOutputRequest
+var JobHandlerQueryJobAttemptsArgs_Req_DEFAULT *QueryJobAttemptsRequest
 
-func (p *JobHandlerDownloadJobOutputArgs) GetReq() (v *DownloadJobOutputRequest) {
+func (p *JobHandlerQueryJobAttemptsArgs) GetReq() (v *QueryJobAttemptsRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerDownloadJobOutputArgs_Req_DEFAULT
+		return JobHandlerQueryJobAttemptsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerDownloadJobOutputArgs = map[int16]string{
+var fieldIDToName_JobHandlerQueryJobAttemptsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) IsSetReq() bool {
+func (p *JobHandlerQueryJobAttemptsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttemptsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6044,7 +14142,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDownloadJobOutputArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobAttemptsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6054,8 +14152,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDownloadJobOutputRequest()
+func (p *JobHandlerQueryJobAttemptsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryJobAttemptsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6063,9 +14161,9 @@ func (p *JobHandlerDownloadJobOutputArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttemptsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DownloadJobOutput_args"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobAttempts_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6091,7 +14189,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttemptsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6108,40 +14206,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) String() string {
+func (p *JobHandlerQueryJobAttemptsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerDownloadJobOutputArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryJobAttemptsArgs(%+v)", *p)
 
 }
 
-type JobHandlerDownloadJobOutputResult struct {
-	Success *DownloadJobOutputResponse `thrift:"success,0,optional"`
+type JobHandlerQueryJobAttemptsResult struct {
+	Success *QueryJobAttemptsResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerDownloadJobOutputResult() *JobHandlerDownloadJobOutputResult {
-	return &JobHandlerDownloadJobOutputResult{}
+func NewJobHandlerQueryJobAttemptsResult() *JobHandlerQueryJobAttemptsResult {
+	return &JobHandlerQueryJobAttemptsResult{}
 }
 
-var JobHandlerDownloadJobOutputResult_Success_DEFAULT *DownloadJobOutputResponse
+var JobHandlerQueryJobAttemptsResult_Success_DEFAULT *QueryJobAttemptsResponse
 
-func (p *JobHandlerDownloadJobOutputResult) GetSuccess() (v *DownloadJobOutputResponse) {
+func (p *JobHandlerQueryJobAttemptsResult) GetSuccess() (v *QueryJobAttemptsResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerDownloadJobOutputResult_Success_DEFAULT
+		return JobHandlerQueryJobAttemptsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerDownloadJobOutputResult = map[int16]string{
+var fieldIDToName_JobHandlerQueryJobAttemptsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerDownloadJobOutputResult) IsSetSuccess() bool {
+func (p *JobHandlerQueryJobAttemptsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerDownloadJobOutputResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttemptsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6187,7 +14285,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDownloadJobOutputResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobAttemptsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6197,8 +14295,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDownloadJobOutputResponse()
+func (p *JobHandlerQueryJobAttemptsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryJobAttemptsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6206,9 +14304,9 @@ func (p *JobHandlerDownloadJobOutputResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *JobHandlerDownloadJobOutputResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttemptsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DownloadJobOutput_result"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobAttempts_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6234,7 +14332,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttemptsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6253,40 +14351,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputResult) String() string {
+func (p *JobHandlerQueryJobAttemptsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerDownloadJobOutputResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryJobAttemptsResult(%+v)", *p)
 
 }
 
-type JobHandlerQueryJobAttestationReportArgs struct {
-	Req *QueryJobAttestationRequest `thrift:"req,1"`
+type JobHandlerQueryJobStateArgs struct {
+	Req *QueryJobStateRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerQueryJobAttestationReportArgs() *JobHandlerQueryJobAttestationReportArgs {
-	return &JobHandlerQueryJobAttestationReportArgs{}
+func NewJobHandlerQueryJobStateArgs() *JobHandlerQueryJobStateArgs {
+	return &JobHandlerQueryJobStateArgs{}
 }
 
-var JobHandlerQueryJobAttestationReportArgs_Req_DEFAULT *QueryJobAttestationRequest
+var JobHandlerQueryJobStateArgs_Req_DEFAULT *QueryJobStateRequest
 
-func (p *JobHandlerQueryJobAttestationReportArgs) GetReq() (v *QueryJobAttestationRequest) {
+func (p *JobHandlerQueryJobStateArgs) GetReq() (v *QueryJobStateRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerQueryJobAttestationReportArgs_Req_DEFAULT
+		return JobHandlerQueryJobStateArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerQueryJobAttestationReportArgs = map[int16]string{
+var fieldIDToName_JobHandlerQueryJobStateArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) IsSetReq() bool {
+func (p *JobHandlerQueryJobStateArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobStateArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6332,7 +14430,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobAttestationReportArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobStateArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6342,8 +14440,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewQueryJobAttestationRequest()
+func (p *JobHandlerQueryJobStateArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryJobStateRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6351,9 +14449,9 @@ func (p *JobHandlerQueryJobAttestationReportArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobStateArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobAttestationReport_args"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobState_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6379,7 +14477,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobStateArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6396,40 +14494,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) String() string {
+func (p *JobHandlerQueryJobStateArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerQueryJobAttestationReportArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryJobStateArgs(%+v)", *p)
 
 }
 
-type JobHandlerQueryJobAttestationReportResult struct {
-	Success *QueryJobAttestationResponse `thrift:"success,0,optional"`
+type JobHandlerQueryJobStateResult struct {
+	Success *QueryJobStateResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerQueryJobAttestationReportResult() *JobHandlerQueryJobAttestationReportResult {
-	return &JobHandlerQueryJobAttestationReportResult{}
+func NewJobHandlerQueryJobStateResult() *JobHandlerQueryJobStateResult {
+	return &JobHandlerQueryJobStateResult{}
 }
 
-var JobHandlerQueryJobAttestationReportResult_Success_DEFAULT *QueryJobAttestationResponse
+var JobHandlerQueryJobStateResult_Success_DEFAULT *QueryJobStateResponse
 
-func (p *JobHandlerQueryJobAttestationReportResult) GetSuccess() (v *QueryJobAttestationResponse) {
+func (p *JobHandlerQueryJobStateResult) GetSuccess() (v *QueryJobStateResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerQueryJobAttestationReportResult_Success_DEFAULT
+		return JobHandlerQueryJobStateResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerQueryJobAttestationReportResult = map[int16]string{
+var fieldIDToName_JobHandlerQueryJobStateResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) IsSetSuccess() bool {
+func (p *JobHandlerQueryJobStateResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobStateResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6475,7 +14573,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobAttestationReportResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobStateResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6485,8 +14583,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewQueryJobAttestationResponse()
+func (p *JobHandlerQueryJobStateResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryJobStateResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6494,9 +14592,9 @@ func (p *JobHandlerQueryJobAttestationReportResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobStateResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobAttestationReport_result"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobState_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6522,7 +14620,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobStateResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6541,10 +14639,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) String() string {
+func (p *JobHandlerQueryJobStateResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerQueryJobAttestationReportResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryJobStateResult(%+v)", *p)
 
 }
@@ -0,0 +1,62 @@
+// Code generated by hertz generator.
+
+package approval
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _v1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _approvalMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryapprovalMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reviewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reviewjobMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _decideMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _decidejobMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _auditMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryauditlogMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -0,0 +1,87 @@
+// Code generated by hertz generator.
+
+package dataset
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _v1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _datasetMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _registerMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _registerdatasetMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _querydatasetMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _describeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _describedatasetMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _previewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _downloadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _downloaddatasetpreviewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _query0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _querydatasetpreviewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _uploadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _uploaddatasetpreviewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -0,0 +1,62 @@
+// Code generated by hertz generator.
+
+package egress
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _v1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _egressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryjoboutputsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _downloadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _downloadquarantinedoutputMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _decideMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _decidejoboutputMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _redactMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _redactjoboutputMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
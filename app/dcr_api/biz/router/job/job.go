@@ -21,9 +21,21 @@ func Register(r *server.Hertz) {
 		_v1 := root.Group("/v1", _v1Mw()...)
 		{
 			_job := _v1.Group("/job", _jobMw()...)
+			{
+				_attempts := _job.Group("/attempts", _attemptsMw()...)
+				_attempts.POST("/", append(_queryjobattemptsMw(), job.QueryJobAttempts)...)
+			}
 			{
 				_attestation := _job.Group("/attestation", _attestationMw()...)
 				_attestation.POST("/", append(_queryjobattestationreportMw(), job.QueryJobAttestationReport)...)
+				{
+					_verify := _attestation.Group("/verify", _verifyMw()...)
+					_verify.POST("/", append(_verifyjobattestationMw(), job.VerifyJobAttestation)...)
+				}
+			}
+			{
+				_cancel := _job.Group("/cancel", _cancelMw()...)
+				_cancel.POST("/", append(_canceljobMw(), job.CancelJob)...)
 			}
 			{
 				_delete := _job.Group("/delete", _deleteMw()...)
@@ -39,11 +51,27 @@ func Register(r *server.Hertz) {
 					_download := _output.Group("/download", _downloadMw()...)
 					_download.POST("/", append(_downloadjoboutputMw(), job.DownloadJobOutput)...)
 				}
+				{
+					_list := _output.Group("/list", _listMw()...)
+					_list.POST("/", append(_listjoboutputsMw(), job.ListJobOutputs)...)
+				}
+				{
+					_verify0 := _output.Group("/verify", _verify0Mw()...)
+					_verify0.POST("/", append(_verifyjoboutputMw(), job.VerifyJobOutput)...)
+				}
+			}
+			{
+				_provenance := _job.Group("/provenance", _provenanceMw()...)
+				_provenance.POST("/", append(_queryjobprovenanceMw(), job.QueryJobProvenance)...)
 			}
 			{
 				_query := _job.Group("/query", _queryMw()...)
 				_query.POST("/", append(_queryjobMw(), job.QueryJob)...)
 			}
+			{
+				_state := _job.Group("/state", _stateMw()...)
+				_state.POST("/", append(_queryjobstateMw(), job.QueryJobState)...)
+			}
 			{
 				_submit := _job.Group("/submit", _submitMw()...)
 				_submit.POST("/", append(_submitjobMw(), job.SubmitJob)...)
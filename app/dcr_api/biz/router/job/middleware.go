@@ -105,3 +105,73 @@ func _submitjobMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _provenanceMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryjobprovenanceMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listjoboutputsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _verifyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _verifyjoboutputMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _verify0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _verifyjobattestationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _attemptsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryjobattemptsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _stateMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryjobstateMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _cancelMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _canceljobMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -0,0 +1,42 @@
+// Code generated by hertz generator.
+
+package policy
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _v1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _policyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createpolicyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _querypolicyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
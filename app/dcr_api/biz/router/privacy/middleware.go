@@ -0,0 +1,57 @@
+// Code generated by hertz generator.
+
+package privacy
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _v1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _privacyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _budgetMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setprivacybudgetMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryprivacybudgetMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _ledgerMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryprivacyledgerMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
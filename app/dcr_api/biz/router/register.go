@@ -4,11 +4,26 @@ package router
 
 import (
 	"github.com/cloudwego/hertz/pkg/app/server"
+	approval "github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/router/approval"
+	dataset "github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/router/dataset"
+	egress "github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/router/egress"
 	job "github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/router/job"
+	policy "github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/router/policy"
+	privacy "github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/router/privacy"
 )
 
 // GeneratedRegister registers routers generated by IDL.
 func GeneratedRegister(r *server.Hertz) {
 	//INSERT_POINT: DO NOT DELETE THIS LINE!
+	privacy.Register(r)
+
+	egress.Register(r)
+
+	approval.Register(r)
+
+	dataset.Register(r)
+
+	policy.Register(r)
+
 	job.Register(r)
 }
@@ -0,0 +1,290 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/approval"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/cloud"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+)
+
+const (
+	AuditRequestApproval = "request_approval"
+	AuditReview          = "review"
+	AuditApprove         = "approve"
+	AuditReject          = "reject"
+)
+
+type ApprovalService struct {
+	ctx context.Context
+}
+
+// NewApprovalService create approval service
+func NewApprovalService(ctx context.Context) *ApprovalService {
+	return &ApprovalService{ctx: ctx}
+}
+
+func convertApprovalToModel(a *db.JobApproval) *approval.Approval {
+	return &approval.Approval{
+		JobUUID:   a.JobUUID,
+		Provider:  a.Provider,
+		Decision:  a.Decision,
+		Comment:   a.Comment,
+		CreatedAt: a.CreatedAt.Format(utils.Layout),
+		UpdatedAt: a.UpdatedAt.Format(utils.Layout),
+	}
+}
+
+func convertAuditLogToModel(l *db.AuditLog) *approval.AuditLog {
+	return &approval.AuditLog{
+		ID:        int64(l.ID),
+		JobUUID:   l.JobUUID,
+		Actor:     l.Actor,
+		Action:    l.Action,
+		Detail:    l.Detail,
+		CreatedAt: l.CreatedAt.Format(utils.Layout),
+	}
+}
+
+// RequestApprovals asks the providers of the job's datasets that require approval to approve the built image,
+// it returns false if no approval is required and the job can run right away
+func (as *ApprovalService) RequestApprovals(j *db.Job) (bool, error) {
+	datasets, err := NewDatasetService(as.ctx).GetJobDatasets(j)
+	if err != nil {
+		return false, err
+	}
+	providers := map[string]bool{}
+	for _, d := range datasets {
+		if d.RequireApproval {
+			providers[d.Provider] = true
+		}
+	}
+	if len(providers) == 0 {
+		return false, nil
+	}
+	names := []string{}
+	for p := range providers {
+		names = append(names, p)
+	}
+	sort.Strings(names)
+	records := []*db.JobApproval{}
+	logs := []*db.AuditLog{}
+	for _, p := range names {
+		records = append(records, &db.JobApproval{JobUUID: j.UUID, Provider: p, Decision: db.ApprovalPending})
+		logs = append(logs, &db.AuditLog{
+			JobUUID: j.UUID,
+			Actor:   j.Creator,
+			Action:  AuditRequestApproval,
+			Detail:  fmt.Sprintf("provider %s, image %s@%s", p, j.DockerImage, j.DockerImageDigest),
+		})
+	}
+	if err = db.CreateJobApprovals(records, logs); err != nil {
+		return false, err
+	}
+	hlog.Infof("[ApprovalService] job %s is waiting for the approvals of %v", j.UUID, names)
+	return true, nil
+}
+
+// getProviderApproval returns the approval the provider was asked for, providers can only see the jobs they approve
+func getProviderApproval(approvals []*db.JobApproval, provider string, uuid string) (*db.JobApproval, error) {
+	for _, a := range approvals {
+		if a.Provider == provider {
+			return a, nil
+		}
+	}
+	return nil, fmt.Errorf("provider %s is not asked to approve job %s", provider, uuid)
+}
+
+func (as *ApprovalService) QueryApprovals(req *approval.QueryApprovalRequest) ([]*approval.Approval, int64, error) {
+	approvals, total, err := db.QueryApprovalsByProvider(req.Provider, req.Page, req.PageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	res := []*approval.Approval{}
+	for _, a := range approvals {
+		res = append(res, convertApprovalToModel(a))
+	}
+	return res, total, nil
+}
+
+// ReviewJob returns what the provider approves: the notebook built into the image, the image digest and its SBOM
+func (as *ApprovalService) ReviewJob(req *approval.ReviewJobRequest) (*approval.ReviewJobResponse, error) {
+	j, err := db.QueryJobByUUID(req.UUID)
+	if err != nil {
+		return nil, err
+	}
+	approvals, err := db.QueryJobApprovals(j.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = getProviderApproval(approvals, req.Provider, j.UUID); err != nil {
+		return nil, err
+	}
+	provider := cloud.GetCloudProvider(as.ctx)
+	inputs, err := readBuildInputs(provider, j)
+	if err != nil {
+		return nil, err
+	}
+	notebook, err := readCloudFile(provider, config.GetJobNotebookPath(j.Creator, j.UUID))
+	if err != nil {
+		return nil, err
+	}
+	sbom, err := readCloudFile(provider, config.GetJobSbomPath(j.Creator, j.UUID))
+	if err != nil {
+		return nil, err
+	}
+	records, err := db.QueryJobDatasets([]string{j.UUID})
+	if err != nil {
+		return nil, err
+	}
+	res := &approval.ReviewJobResponse{
+		Creator:           j.Creator,
+		JupyterFileName:   j.JupyterFileName,
+		Notebook:          string(notebook),
+		NotebookSha256:    inputs.NotebookSha256,
+		DockerImage:       j.DockerImage,
+		DockerImageDigest: j.DockerImageDigest,
+		Sbom:              string(sbom),
+		Datasets:          []string{},
+		Approvals:         []*approval.Approval{},
+	}
+	for _, r := range records[j.UUID] {
+		res.Datasets = append(res.Datasets, formatDatasetDeclaration(r))
+	}
+	for _, a := range approvals {
+		res.Approvals = append(res.Approvals, convertApprovalToModel(a))
+	}
+	err = db.CreateAuditLog(&db.AuditLog{JobUUID: j.UUID, Actor: req.Provider, Action: AuditReview, Detail: fmt.Sprintf("image %s@%s", j.DockerImage, j.DockerImageDigest)})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DecideJob records the decision of the provider, a rejection ends the job and the last approval runs it
+func (as *ApprovalService) DecideJob(req *approval.DecideJobRequest) error {
+	j, err := db.QueryJobByUUID(req.UUID)
+	if err != nil {
+		return err
+	}
+	if j.JobStatus != int(job.JobStatus_PendingApproval) {
+		return fmt.Errorf("job %s is not pending approval", j.UUID)
+	}
+	approvals, err := db.QueryJobApprovals(j.UUID)
+	if err != nil {
+		return err
+	}
+	a, err := getProviderApproval(approvals, req.Provider, j.UUID)
+	if err != nil {
+		return err
+	}
+	a.Decision = req.Decision
+	a.Comment = req.Comment
+	action := AuditApprove
+	if a.Decision == db.ApprovalRejected {
+		action = AuditReject
+	}
+	decided, err := db.DecideJobApproval(a, &db.AuditLog{
+		JobUUID: j.UUID,
+		Actor:   req.Provider,
+		Action:  action,
+		Detail:  fmt.Sprintf("image %s@%s, comment: %s", j.DockerImage, j.DockerImageDigest, req.Comment),
+	})
+	if err != nil {
+		return err
+	}
+	if !decided {
+		return fmt.Errorf("provider %s already decided on job %s", req.Provider, j.UUID)
+	}
+	hlog.Infof("[ApprovalService] provider %s %s job %s", req.Provider, req.Decision, j.UUID)
+
+	js := NewJobService(as.ctx)
+	if a.Decision == db.ApprovalRejected {
+		j.FailureReason = fmt.Sprintf("rejected by provider %s: %s", req.Provider, req.Comment)
+		err = transitionJob(j, job.JobStatus_ApprovalRejected)
+		if errors.Is(err, errno.JobVersionConflictErr) {
+			// another decision already moved the job
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		js.releaseJobResources(j)
+		return nil
+	}
+	// the approvals are read again once the decision is recorded: of providers approving at the same time,
+	// at least the last one to record its decision sees all the approvals, and the version of the job
+	// lets only one of them run it
+	approvals, err = db.QueryJobApprovals(j.UUID)
+	if err != nil {
+		return err
+	}
+	for _, other := range approvals {
+		if other.Decision != db.ApprovalApproved {
+			return nil
+		}
+	}
+	err = transitionJob(j, job.JobStatus_VMWaiting)
+	if errors.Is(err, errno.JobVersionConflictErr) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// the token of the request belongs to the provider, not to the creator. The user token is not passed
+	// to the VM yet, see getTokenForStage2 of the cloud provider
+	if err = js.RunJob(as.ctx, j, ""); err != nil {
+		js.failJob(j, err)
+		return err
+	}
+	return transitionJob(j, job.JobStatus_VMRunning)
+}
+
+// QueryAuditLogs returns the audit log of a job to its creator and to the providers asked to approve it
+func (as *ApprovalService) QueryAuditLogs(req *approval.QueryAuditLogRequest) ([]*approval.AuditLog, error) {
+	j, err := db.QueryJobByUUID(req.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if j.Creator != req.Actor {
+		approvals, err := db.QueryJobApprovals(j.UUID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = getProviderApproval(approvals, req.Actor, j.UUID); err != nil {
+			return nil, err
+		}
+	}
+	logs, err := db.QueryAuditLogs(j.UUID)
+	if err != nil {
+		return nil, err
+	}
+	res := []*approval.AuditLog{}
+	for _, l := range logs {
+		res = append(res, convertAuditLogToModel(l))
+	}
+	return res, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/attestation"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/cloud"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/manifest"
+)
+
+type AttestationService struct {
+	ctx context.Context
+}
+
+// NewAttestationService create attestation service
+func NewAttestationService(ctx context.Context) *AttestationService {
+	return &AttestationService{ctx: ctx}
+}
+
+// VerifyJobAttestation checks the signature of the attestation token of a job and the commitment written by the
+// TEE. A nonce of the token must be the hash of the commitment, and each field of the commitment must match the
+// job. It returns the commitment and the attested output manifest
+func (as *AttestationService) VerifyJobAttestation(j *db.Job) (*attestation.Commitment, *manifest.Manifest, error) {
+	if j.AttestationReport == "" {
+		return nil, nil, fmt.Errorf("job %s has no attestation token", j.UUID)
+	}
+	content, err := attestation.FetchJWKS(as.ctx, config.GetAttestationIssuer())
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, err := attestation.ParseJWKS(content)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the token has expired by the time the outputs are reviewed, its expiry is not checked
+	opts := attestation.TokenOptions{Issuer: config.GetAttestationIssuer(), Audience: config.GetAttestationAudience()}
+	claims, err := attestation.VerifyToken(j.AttestationReport, keys, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("attestation token of job %s: %w", j.UUID, err)
+	}
+	teeType, err := config.LookupTEEType(j.TEEType)
+	if err != nil {
+		return nil, nil, err
+	}
+	if claims.HardwareModel != teeType.HardwareModel {
+		return nil, nil, fmt.Errorf("job %s ran on hardware %s instead of %s", j.UUID, claims.HardwareModel, teeType.HardwareModel)
+	}
+	if claims.NetworkMode() != j.NetworkMode {
+		return nil, nil, fmt.Errorf("job %s ran in network mode %q instead of %s", j.UUID, claims.NetworkMode(), j.NetworkMode)
+	}
+	provider := cloud.GetCloudProvider(as.ctx)
+	content, err = readCloudFile(provider, config.GetJobCommitmentPath(j.Creator, j.UUID))
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := attestation.ParseCommitment(content)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce, err := c.Nonce()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !claims.HasNonce(nonce) {
+		return nil, nil, fmt.Errorf("commitment %s of job %s is not a nonce of its attestation token", nonce, j.UUID)
+	}
+	if c.JobUUID != j.UUID {
+		return nil, nil, fmt.Errorf("commitment is for job %s instead of job %s", c.JobUUID, j.UUID)
+	}
+	if c.ImageDigest != j.DockerImageDigest || claims.ImageDigest() != j.DockerImageDigest {
+		return nil, nil, fmt.Errorf("job %s ran image %s committing to %s, the accepted image is %s",
+			j.UUID, claims.ImageDigest(), c.ImageDigest, j.DockerImageDigest)
+	}
+	datasets, err := NewDatasetService(as.ctx).GetJobDatasets(j)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := []string{}
+	for _, d := range datasets {
+		names = append(names, d.Name)
+	}
+	sort.Strings(names)
+	if !slices.Equal(names, c.Names()) {
+		return nil, nil, fmt.Errorf("job %s committed to datasets %v instead of %v", j.UUID, c.Names(), names)
+	}
+	content, err = readCloudFile(provider, config.GetJobManifestPath(j.Creator, j.UUID))
+	if err != nil {
+		return nil, nil, err
+	}
+	m, err := manifest.Parse(content)
+	if err != nil {
+		return nil, nil, err
+	}
+	if m.Root != c.ManifestRoot {
+		return nil, nil, fmt.Errorf("root hash %s of the output manifest of job %s is not committed to", m.Root, j.UUID)
+	}
+	encryptedName := config.GetEncryptedJobOutputFilename(j.UUID, j.JupyterFileName)
+	content, err = readCloudFile(provider, config.GetJobQuarantinePath(j.Creator, j.UUID, encryptedName))
+	if err != nil {
+		return nil, nil, err
+	}
+	digest := sha256.Sum256(content)
+	if sum := hex.EncodeToString(digest[:]); sum != c.EncryptedOutputSha256 {
+		return nil, nil, fmt.Errorf("sha256 %s of the encrypted output of job %s is not committed to", sum, j.UUID)
+	}
+	return c, m, nil
+}
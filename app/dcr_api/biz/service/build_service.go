@@ -1,24 +1,548 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/docker/docker/pkg/archive"
+	"github.com/pkg/errors"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/cloud"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/provenance"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
 )
 
+const (
+	KanikoBuilder   = "kaniko"
+	BuildKitBuilder = "buildkit"
+	DockerBuilder   = "docker"
+)
+
+// ImageBuilder builds and pushes the docker image of a job.
+// Builders running as kubernetes jobs are picked up by the monitor through utils.BuilderLabel,
+// the others report the result with reportBuildResult once the build is done.
+type ImageBuilder interface {
+	BuildImage(j *db.Job, token string) error
+}
+
+func NewImageBuilder(c context.Context) (ImageBuilder, error) {
+	builderType := getBuilderType()
+	switch builderType {
+	case KanikoBuilder:
+		return NewKanikoService(c), nil
+	case BuildKitBuilder:
+		return NewBuildKitService(c), nil
+	case DockerBuilder:
+		return NewDockerBuildService(c), nil
+	default:
+		return nil, fmt.Errorf("unknown image builder %s", builderType)
+	}
+}
+
+// getBuilderType returns the configured builder, by default kaniko inside kubernetes and docker otherwise
+func getBuilderType() string {
+	builderType := config.GetBuilderType()
+	if builderType == "" {
+		builderType = DockerBuilder
+		if utils.RunningInsideKubernetes() {
+			builderType = KanikoBuilder
+		}
+	}
+	return builderType
+}
+
 func BuildImage(c context.Context, j db.Job, token string) error {
-	if utils.RunningInsideKubernetes() {
-		// use kaniko
-		kanikoService := NewKanikoService(c)
-		err := kanikoService.BuildImage(&j, token)
+	builder, err := NewImageBuilder(c)
+	if err != nil {
+		return err
+	}
+	err = builder.BuildImage(&j, token)
+	if err != nil {
+		hlog.Errorf("failed to run task %+v", err)
+		return err
+	}
+	return nil
+}
+
+// reportBuildResult moves the job out of ImageBuilding, it's the in-process counterpart of the monitor
+func reportBuildResult(j *db.Job, token string, digest string, buildErr error) {
+	req := &job.UpdateJobStatusRequest{
+		UUID:              j.UUID,
+		Creator:           j.Creator,
+		Status:            job.JobStatus_VMWaiting,
+		DockerImage:       config.GetJobDockerImageFull(j.Creator, j.UUID),
+		DockerImageDigest: digest,
+		AccessToken:       token,
+	}
+	if buildErr != nil {
+		hlog.Errorf("[BuildService] failed to build image for job %s: %+v", j.UUID, buildErr)
+		req.Status = job.JobStatus_ImageBuildingFailed
+		req.DockerImageDigest = ""
+		req.FailureReason = buildErr.Error()
+	}
+	err := NewJobService(context.Background()).UpdateJob(req)
+	if err != nil {
+		hlog.Errorf("[BuildService] failed to update job %s: %+v", j.UUID, err)
+	}
+}
+
+// prepareBuild creates the build context and the build args, and records the build inputs
+// so the SBOM and provenance of the image can be generated once it's built
+func prepareBuild(ctx context.Context, j *db.Job, builderType string) (io.ReadCloser, []string, error) {
+	provider := cloud.GetCloudProvider(ctx)
+	startedOn := time.Now()
+	directory, err := createBuildDirectory(ctx, j)
+	defer func() {
+		if err := os.RemoveAll(directory); err != nil {
+			hlog.Warnf("[BuildService] failed to remove build directory %s: %+v", directory, err)
+		}
+	}()
+	if err != nil {
+		return nil, nil, err
+	}
+	// pin the base image, so the image can be reproduced from the recorded inputs
+	baseImage := config.GetBaseDockerImage()
+	baseImageDigest, err := provider.GetImageDigest(baseImage)
+	if err != nil {
+		return nil, nil, err
+	}
+	args, err := getBuildArgs(ctx, j, fmt.Sprintf("%s@%s", config.GetBaseDockerImageRepository(), baseImageDigest))
+	if err != nil {
+		return nil, nil, err
+	}
+	inputs, err := getBuildInputs(j, directory, args)
+	if err != nil {
+		return nil, nil, err
+	}
+	inputs.Builder = builderType
+	inputs.BuilderImage = getBuilderImage(builderType)
+	inputs.BaseImage = baseImage
+	inputs.BaseImageDigest = baseImageDigest
+	inputs.StartedOn = startedOn
+	inputsBytes, err := json.Marshal(inputs)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to marshal build inputs")
+	}
+	err = provider.UploadFile(bytes.NewReader(inputsBytes), config.GetBuildInputsPath(j.Creator, j.UUID), false)
+	if err != nil {
+		return nil, nil, err
+	}
+	// keep the notebook that goes into the image, data providers review it before approving the job
+	if err = uploadJobNotebook(provider, j, directory, inputs); err != nil {
+		return nil, nil, err
+	}
+	buildCtx, err := archiveBuildDirectory(directory)
+	if err != nil {
+		return nil, nil, err
+	}
+	return buildCtx, args, nil
+}
+
+// buildContext is the archived build context of a job, the archive is removed when it's closed
+type buildContext struct {
+	*os.File
+}
+
+func (b *buildContext) Close() error {
+	err := b.File.Close()
+	if removeErr := os.Remove(b.Name()); removeErr != nil && err == nil {
+		err = errors.Wrap(removeErr, "failed to remove build context")
+	}
+	return err
+}
+
+// archiveBuildDirectory writes the whole build context to a tar file next to the directory,
+// builds running in the background don't depend on the directory once it returns
+func archiveBuildDirectory(directory string) (io.ReadCloser, error) {
+	tarStream, err := archive.TarWithOptions(directory, &archive.TarOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to archive build directory")
+	}
+	defer tarStream.Close()
+	file, err := os.Create(directory + ".tar")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create build context")
+	}
+	buildCtx := &buildContext{File: file}
+	if _, err = io.Copy(file, tarStream); err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		_ = buildCtx.Close()
+		return nil, errors.Wrap(err, "failed to write build context")
+	}
+	return buildCtx, nil
+}
+
+// createBuildDirectory creates the build directory of the job, every job has its own directory
+// so builds of the same creator never share files
+func createBuildDirectory(ctx context.Context, j *db.Job) (string, error) {
+	provider := cloud.GetCloudProvider(ctx)
+	workingDir, err := utils.GetWorkDirectory()
+	if err != nil {
+		return "", err
+	}
+	creator := j.Creator
+	directory := filepath.Join(workingDir, filepath.Clean(creator), j.UUID)
+	// remove what's left of an interrupted build of the job
+	err = os.RemoveAll(directory)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to remove job directory")
+	}
+	err = os.MkdirAll(directory, 0700)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create directory for job")
+	}
+	userWorkspaceTar := filepath.Join(directory, config.GetUserWorkspaceFile(creator))
+	// download user's workspace, it's tar.gz file
+	if err = provider.DownloadFile(config.GetUserWorkSpacePath(creator), userWorkspaceTar); err != nil {
+		return "", err
+	}
+	// unzip the user's workspace
+	if err = utils.UnTarGz(userWorkspaceTar, directory); err != nil {
+		return "", err
+	}
+	dockerFile := utils.GetDockerFile()
+	// copy dockerfile to directory
+	err = utils.CopyFile(dockerFile, fmt.Sprintf("%s/Dockerfile", directory))
+	if err != nil {
+		return "", err
+	}
+	configPath := utils.GetConfigFile()
+	// copy config file to directory
+	err = utils.CopyFile(configPath, fmt.Sprintf("%s/config.yaml", directory))
+	if err != nil {
+		return "", err
+	}
+	return directory, nil
+}
+
+// getBuildInputs hashes the workspace, the notebook, the dockerfile and every file of the build context
+func getBuildInputs(j *db.Job, directory string, args []string) (*provenance.BuildInputs, error) {
+	inputs := &provenance.BuildInputs{
+		UUID:      j.UUID,
+		Creator:   j.Creator,
+		Workspace: config.GetCloudStoragePath(config.GetUserWorkSpacePath(j.Creator)),
+		Notebook:  j.JupyterFileName,
+		BuildArgs: make(map[string]string),
+	}
+	for _, arg := range args {
+		k, v, _ := strings.Cut(arg, "=")
+		inputs.BuildArgs[k] = v
+	}
+	workspaceTar := config.GetUserWorkspaceFile(j.Creator)
+	err := filepath.WalkDir(directory, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		relPath, err := filepath.Rel(directory, path)
 		if err != nil {
-			hlog.Errorf("failed to run task %+v", err)
 			return err
 		}
-	} else {
-		hlog.Error("[BuildService] Not on kubernetes, can't build image")
+		digest, err := utils.GetFileSha256(path)
+		if err != nil {
+			return err
+		}
+		switch {
+		case relPath == workspaceTar:
+			inputs.WorkspaceSha256 = digest
+		case relPath == "Dockerfile":
+			inputs.DockerfileSha256 = digest
+		case filepath.Base(relPath) == j.JupyterFileName:
+			inputs.NotebookSha256 = digest
+		}
+		inputs.Files = append(inputs.Files, provenance.File{Path: filepath.ToSlash(relPath), Sha256: digest})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hash build context")
+	}
+	if inputs.NotebookSha256 == "" {
+		return nil, fmt.Errorf("notebook %s not found in the workspace", j.JupyterFileName)
+	}
+	return inputs, nil
+}
+
+func uploadJobNotebook(provider cloud.CloudProvider, j *db.Job, directory string, inputs *provenance.BuildInputs) error {
+	for _, f := range inputs.Files {
+		if path.Base(f.Path) != j.JupyterFileName || f.Sha256 != inputs.NotebookSha256 {
+			continue
+		}
+		notebook, err := os.Open(filepath.Join(directory, filepath.FromSlash(f.Path)))
+		if err != nil {
+			return errors.Wrap(err, "failed to open notebook")
+		}
+		defer notebook.Close()
+		return provider.UploadFile(notebook, config.GetJobNotebookPath(j.Creator, j.UUID), false)
+	}
+	return fmt.Errorf("notebook %s not found in the workspace", j.JupyterFileName)
+}
+
+// uploadBuildCtx stores the build context in cloud storage for builders running in the cluster
+func uploadBuildCtx(ctx context.Context, buildCtx io.ReadCloser, creator string, UUID string) error {
+	defer buildCtx.Close()
+	provider := cloud.GetCloudProvider(ctx)
+	return provider.UploadFile(buildCtx, config.GetBuildContextPath(creator, UUID), true)
+}
+
+// deleteBuildContext deletes the build context uploaded for the builders running in the cluster,
+// once the build of the job is over
+func deleteBuildContext(ctx context.Context, j *db.Job) {
+	if getBuilderType() == DockerBuilder {
+		return
+	}
+	provider := cloud.GetCloudProvider(ctx)
+	if err := provider.DeleteFile(config.GetBuildContextPath(j.Creator, j.UUID)); err != nil {
+		hlog.Warnf("[BuildService] failed to delete build context of job %s: %+v", j.UUID, err)
+	}
+}
+
+// deleteBuildJob deletes the kubernetes job building the image of a job that ended before its build did,
+// the monitor only deletes the build jobs whose result it reported
+func deleteBuildJob(ctx context.Context, j *db.Job) {
+	builderType := getBuilderType()
+	if builderType == DockerBuilder {
+		return
+	}
+	clientSet, err := newKubernetesClientSet()
+	if err != nil {
+		hlog.Warnf("[BuildService] failed to delete build job of job %s: %+v", j.UUID, err)
+		return
+	}
+	deletePolicy := metav1.DeletePropagationForeground
+	err = clientSet.BatchV1().Jobs(utils.GetNamespace()).Delete(ctx, getBuildJobName(builderType, j.UUID), metav1.DeleteOptions{
+		PropagationPolicy: &deletePolicy,
+	})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		hlog.Warnf("[BuildService] failed to delete build job of job %s: %+v", j.UUID, err)
+	}
+}
+
+func getBuildJobName(builderType string, UUID string) string {
+	return fmt.Sprintf("%s-%s", builderType, UUID)
+}
+
+// getBuildArgs returns the build args of the TEE dockerfile as KEY=VALUE
+func getBuildArgs(ctx context.Context, j *db.Job, baseImage string) ([]string, error) {
+	UUID := j.UUID
+	creator := j.Creator
+	provider := cloud.GetCloudProvider(ctx)
+	trustedServiceAccountEmail, err := provider.GetServiceAccountEmail()
+	if err != nil {
+		return nil, err
+	}
+	datasets, err := db.QueryJobDatasets([]string{UUID})
+	if err != nil {
+		return nil, err
+	}
+	// the stage-2 data of each dataset is fetched under its logical name
+	datasetNames := []string{}
+	for _, d := range datasets[UUID] {
+		datasetNames = append(datasetNames, d.DatasetName)
+	}
+	return []string{
+		fmt.Sprintf("CREATOR=%s", creator),
+		fmt.Sprintf("JOB_UUID=%s", UUID),
+		// outputs go to the quarantine directory of the job, they are released once reviewed
+		fmt.Sprintf("OUTPUTPATH=%s", config.GetCloudStoragePath(config.GetJobQuarantinePath(creator, UUID, ""))),
+		fmt.Sprintf("ENCRYPTED_FILENAME=%s", config.GetEncryptedJobOutputFilename(UUID, j.JupyterFileName)),
+		fmt.Sprintf("ENCRYPTED_CLOUDSTORAGE_PATH=%s", config.GetCloudStoragePath(config.GetJobQuarantinePath(creator, UUID, config.GetEncryptedJobOutputFilename(UUID, j.JupyterFileName)))),
+		fmt.Sprintf("JUPYTER_FILENAME=%s", j.JupyterFileName),
+		fmt.Sprintf("USER_WORKSPACE=%s", config.GetUserWorkSpaceDir(creator)),
+		fmt.Sprintf("BASE_IMAGE=%s", baseImage),
+		fmt.Sprintf("CUSTOMTOKEN_CLOUDSTORAGE_PATH=%s", config.GetCloudStoragePath(config.GetCustomTokenPath(creator, UUID))),
+		fmt.Sprintf("INSPECTION_REPORT_PATH=%s", config.GetCloudStoragePath(config.GetJobInspectionReportPath(creator, UUID))),
+		fmt.Sprintf("MANIFEST_PATH=%s", config.GetCloudStoragePath(config.GetJobManifestPath(creator, UUID))),
+		fmt.Sprintf("COMMITMENT_PATH=%s", config.GetCloudStoragePath(config.GetJobCommitmentPath(creator, UUID))),
+		fmt.Sprintf("IMPERSONATION_SERVICE_ACCOUNT=%s", trustedServiceAccountEmail),
+		fmt.Sprintf("DATASETS=%s", strings.Join(datasetNames, ",")),
+	}, nil
+}
+
+func getBuilderImage(builderType string) string {
+	switch builderType {
+	case KanikoBuilder:
+		return config.GetBuilderConfig().KanikoImage
+	case BuildKitBuilder:
+		return config.GetBuilderConfig().BuildKitImage
+	default:
+		return builderType
+	}
+}
+
+func getBuildJobAnnotations(j *db.Job, token string) map[string]string {
+	return map[string]string{
+		utils.UserTokenAnnotation:  token,
+		utils.JobUUIDAnnotation:    j.UUID,
+		utils.JobCreatorAnnotation: j.Creator,
+	}
+}
+
+func getBuildJobLabels(builderType string) map[string]string {
+	return map[string]string{
+		utils.BuilderLabel: builderType,
+	}
+}
+
+func newKubernetesClientSet() (*kubernetes.Clientset, error) {
+	clusterConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init cluster config")
+	}
+	clientSet, err := kubernetes.NewForConfig(clusterConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create kubernetes client set")
+	}
+	return clientSet, nil
+}
+
+// newBuildJob wraps the pod spec of a builder into a job, applying the resources, scheduling
+// and pod template override of the builder config
+func newBuildJob(jobName string, namespace string, builderType string, annotations map[string]string, podSpec corev1.PodSpec) (*batchv1.Job, error) {
+	builderConfig := config.GetBuilderConfig()
+	requests, err := getResourceList(builderConfig.Requests)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := getResourceList(builderConfig.Limits)
+	if err != nil {
+		return nil, err
+	}
+	for i := range podSpec.InitContainers {
+		podSpec.InitContainers[i].Resources = corev1.ResourceRequirements{Requests: requests, Limits: limits}
+	}
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].Resources = corev1.ResourceRequirements{Requests: requests, Limits: limits}
+	}
+	// builds only need the workload identity from the metadata server
+	automountServiceAccountToken := false
+	podSpec.AutomountServiceAccountToken = &automountServiceAccountToken
+	if builderConfig.NodePool != "" {
+		podSpec.NodeSelector = map[string]string{
+			"cloud.google.com/gke-nodepool": builderConfig.NodePool,
+		}
+	}
+	if builderConfig.TaintKey != "" {
+		podSpec.Tolerations = append(podSpec.Tolerations, corev1.Toleration{
+			Key:      builderConfig.TaintKey,
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		})
+	}
+	podTemplate, err := mergePodTemplate(corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: getBuildJobLabels(builderType),
+		},
+		Spec: podSpec,
+	}, builderConfig.PodTemplate)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range append(podTemplate.Spec.InitContainers, podTemplate.Spec.Containers...) {
+		if strings.Contains(c.Image, "@sha256:") {
+			continue
+		}
+		if !builderConfig.AllowUnpinnedImages {
+			return nil, fmt.Errorf("image %s of container %s is not pinned by digest", c.Image, c.Name)
+		}
+		hlog.Warnf("[BuildService] image %s of container %s is not pinned by digest", c.Image, c.Name)
+	}
+
+	var activeDeadlineSeconds *int64
+	if builderConfig.ActiveDeadlineSeconds > 0 {
+		activeDeadlineSeconds = &builderConfig.ActiveDeadlineSeconds
+	}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        jobName,
+			Namespace:   namespace,
+			Annotations: annotations,
+			Labels:      getBuildJobLabels(builderType),
+		},
+		Spec: batchv1.JobSpec{
+			TTLSecondsAfterFinished: &builderConfig.TTLSecondsAfterFinished,
+			ActiveDeadlineSeconds:   activeDeadlineSeconds,
+			Template:                podTemplate,
+		},
+	}, nil
+}
+
+func getResourceList(resources config.BuilderResources) (corev1.ResourceList, error) {
+	resourceList := corev1.ResourceList{}
+	for name, value := range map[corev1.ResourceName]string{
+		corev1.ResourceCPU:              resources.CPU,
+		corev1.ResourceMemory:           resources.Memory,
+		corev1.ResourceEphemeralStorage: resources.EphemeralStorage,
+	} {
+		if value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to parse %s quantity", name))
+		}
+		resourceList[name] = quantity
+	}
+	return resourceList, nil
+}
+
+// mergePodTemplate strategic merges the pod template file in the conf directory into the generated template.
+// Containers are merged by name, the build container is named utils.BuildContainerName.
+func mergePodTemplate(podTemplate corev1.PodTemplateSpec, templateFile string) (corev1.PodTemplateSpec, error) {
+	if templateFile == "" {
+		return podTemplate, nil
+	}
+	overrideYaml, err := os.ReadFile(filepath.Join(utils.GetDcrConfDir(), filepath.Clean(templateFile)))
+	if err != nil {
+		return podTemplate, errors.Wrap(err, "failed to read pod template")
+	}
+	override, err := yaml.YAMLToJSON(overrideYaml)
+	if err != nil {
+		return podTemplate, errors.Wrap(err, "failed to convert pod template to json")
+	}
+	original, err := json.Marshal(podTemplate)
+	if err != nil {
+		return podTemplate, errors.Wrap(err, "failed to marshal pod template")
+	}
+	merged, err := strategicpatch.StrategicMergePatch(original, override, corev1.PodTemplateSpec{})
+	if err != nil {
+		return podTemplate, errors.Wrap(err, "failed to merge pod template")
+	}
+	var result corev1.PodTemplateSpec
+	if err = json.Unmarshal(merged, &result); err != nil {
+		return podTemplate, errors.Wrap(err, "failed to unmarshal pod template")
+	}
+	return result, nil
+}
+
+func createKubernetesJob(ctx context.Context, clientSet *kubernetes.Clientset, namespace string, buildJob *batchv1.Job) error {
+	_, err := clientSet.BatchV1().Jobs(namespace).Create(ctx, buildJob, metav1.CreateOptions{})
+	if err != nil {
+		return errors.Wrap(err, "failed to create kubernetes job")
 	}
 	return nil
 }
@@ -0,0 +1,148 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	buildKitWorkspace    = "/workspace"
+	buildKitDockerConfig = "/home/user/.docker"
+	buildKitStateDir     = "/home/user/.local/share/buildkit"
+)
+
+// BuildKitService builds images with rootless buildkit in a kubernetes job
+type BuildKitService struct {
+	ctx       context.Context
+	namespace string
+}
+
+func NewBuildKitService(ctx context.Context) *BuildKitService {
+	return &BuildKitService{
+		ctx:       ctx,
+		namespace: utils.GetNamespace(),
+	}
+}
+
+func (b *BuildKitService) BuildImage(j *db.Job, token string) error {
+	UUID := j.UUID
+	creator := j.Creator
+	buildCtx, args, err := prepareBuild(b.ctx, j, BuildKitBuilder)
+	if err != nil {
+		return err
+	}
+	err = uploadBuildCtx(b.ctx, buildCtx, creator, UUID)
+	if err != nil {
+		return err
+	}
+	clientSet, err := newKubernetesClientSet()
+	if err != nil {
+		return err
+	}
+	buildArgs := []string{
+		"build",
+		"--frontend=dockerfile.v0",
+		fmt.Sprintf("--local=context=%s", buildKitWorkspace),
+		fmt.Sprintf("--local=dockerfile=%s", buildKitWorkspace),
+		fmt.Sprintf("--output=type=image,name=%s,push=true,rewrite-timestamp=true", config.GetJobDockerImageFull(creator, UUID)),
+		// the metadata contains the digest, the monitor picks it up from the pod status
+		"--metadata-file=/dev/termination-log",
+		"--opt=build-arg:SOURCE_DATE_EPOCH=0",
+	}
+	for _, arg := range args {
+		buildArgs = append(buildArgs, fmt.Sprintf("--opt=build-arg:%s", arg))
+	}
+	return b.createBuildJob(clientSet, getBuildJobName(BuildKitBuilder, UUID), j, buildArgs, getBuildJobAnnotations(j, token))
+}
+
+// prepareScript downloads the build context and writes the registry credential for buildkit
+func prepareScript(j *db.Job) string {
+	contextPath := config.GetCloudStoragePath(config.GetBuildContextPath(j.Creator, j.UUID))
+	return strings.Join([]string{
+		"set -e",
+		fmt.Sprintf("gsutil cp %s %s/context.tar.gz", contextPath, buildKitWorkspace),
+		fmt.Sprintf("tar -xzf %[1]s/context.tar.gz -C %[1]s", buildKitWorkspace),
+		fmt.Sprintf("rm %s/context.tar.gz", buildKitWorkspace),
+		`AUTH=$(printf "oauth2accesstoken:%s" "$(gcloud auth print-access-token)" | base64 -w0)`,
+		fmt.Sprintf(`printf '{"auths":{"%s":{"auth":"%%s"}}}' "$AUTH" > %s/config.json`, config.GetDockerRegistryHost(), buildKitDockerConfig),
+	}, "\n")
+}
+
+func (b *BuildKitService) createBuildJob(clientSet *kubernetes.Clientset, jobName string, j *db.Job, buildArgs []string, annotations map[string]string) error {
+	builderConfig := config.GetBuilderConfig()
+	uid := int64(1000)
+	volumeMounts := []corev1.VolumeMount{
+		{Name: "workspace", MountPath: buildKitWorkspace},
+		{Name: "docker-config", MountPath: buildKitDockerConfig},
+	}
+	podSpec := corev1.PodSpec{
+		ServiceAccountName: config.GetK8sPodServiceAccount(),
+		InitContainers: []corev1.Container{
+			{
+				Name:         "prepare",
+				Image:        builderConfig.CloudSdkImage,
+				Command:      []string{"/bin/sh", "-c", prepareScript(j)},
+				VolumeMounts: volumeMounts,
+			},
+		},
+		Containers: []corev1.Container{
+			{
+				Name:    utils.BuildContainerName,
+				Image:   builderConfig.BuildKitImage,
+				Command: []string{"buildctl-daemonless.sh"},
+				Args:    buildArgs,
+				Env: []corev1.EnvVar{
+					{Name: "BUILDKITD_FLAGS", Value: "--oci-worker-no-process-sandbox"},
+					{Name: "DOCKER_CONFIG", Value: buildKitDockerConfig},
+				},
+				SecurityContext: &corev1.SecurityContext{
+					RunAsUser:  &uid,
+					RunAsGroup: &uid,
+					SeccompProfile: &corev1.SeccompProfile{
+						Type: corev1.SeccompProfileTypeUnconfined,
+					},
+					AppArmorProfile: &corev1.AppArmorProfile{
+						Type: corev1.AppArmorProfileTypeUnconfined,
+					},
+				},
+				VolumeMounts: append(volumeMounts, corev1.VolumeMount{
+					Name: "buildkitd", MountPath: buildKitStateDir,
+				}),
+				TerminationMessagePath:   "/dev/termination-log",
+				TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+			},
+		},
+		Volumes: []corev1.Volume{
+			{Name: "workspace", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			{Name: "docker-config", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			{Name: "buildkitd", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		},
+		RestartPolicy: "Never",
+	}
+	buildJob, err := newBuildJob(jobName, b.namespace, BuildKitBuilder, annotations, podSpec)
+	if err != nil {
+		return err
+	}
+	return createKubernetesJob(b.ctx, clientSet, b.namespace, buildJob)
+}
@@ -0,0 +1,356 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/dataset"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/cloud"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	attestationpolicy "github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/policy"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+)
+
+const datasetDecrypterRole = "roles/cloudkms.cryptoKeyDecrypter"
+
+type DatasetService struct {
+	ctx context.Context
+}
+
+// NewDatasetService create dataset service
+func NewDatasetService(ctx context.Context) *DatasetService {
+	return &DatasetService{ctx: ctx}
+}
+
+func convertDatasetToModel(d *db.Dataset) *dataset.Dataset {
+	return &dataset.Dataset{
+		ID:              int64(d.ID),
+		Name:            d.Name,
+		Provider:        d.Provider,
+		Description:     d.Description,
+		Location:        config.GetCloudStoragePath(d.StoragePath),
+		KeyName:         config.GetKeyFullName(d.KeyId),
+		AccessPolicy:    d.AccessPolicy,
+		CreatedAt:       d.CreatedAt.Format(utils.Layout),
+		UpdatedAt:       d.UpdatedAt.Format(utils.Layout),
+		RequireApproval: d.RequireApproval,
+		DpEpsilonBudget: d.DpEpsilonBudget,
+		DpDeltaBudget:   d.DpDeltaBudget,
+	}
+}
+
+// RegisterDataset creates the key of the dataset and stores it, the provider encrypts the data with the key
+// and uploads it to the location of the dataset
+func (ds *DatasetService) RegisterDataset(req *dataset.RegisterDatasetRequest) (*dataset.Dataset, error) {
+	if req.AccessPolicy != "" {
+		if _, err := attestationpolicy.Parse([]byte(req.AccessPolicy)); err != nil {
+			return nil, err
+		}
+	}
+	_, err := db.QueryDatasetByName(req.Name)
+	if err == nil {
+		return nil, fmt.Errorf("dataset %s already exists", req.Name)
+	} else if !stderrors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	provider := cloud.GetCloudProvider(ds.ctx)
+	keyId := config.GetDatasetKey(req.Name)
+	exist, err := provider.CheckIfKeyExists(keyId)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		err = provider.CreateSymmetricKeys(keyId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.Encrypter != "" {
+		err = provider.GrantKeyRole(req.Encrypter, keyId, "roles/cloudkms.cryptoKeyEncrypter")
+		if err != nil {
+			return nil, err
+		}
+	}
+	d := db.Dataset{
+		Name:            req.Name,
+		Provider:        req.Provider,
+		Description:     req.Description,
+		StoragePath:     config.GetDatasetStoragePath(req.Name),
+		KeyId:           keyId,
+		AccessPolicy:    req.AccessPolicy,
+		RequireApproval: req.RequireApproval,
+		DpEpsilonBudget: req.DpEpsilonBudget,
+		DpDeltaBudget:   req.DpDeltaBudget,
+	}
+	err = db.CreateDataset(&d)
+	if err != nil {
+		return nil, err
+	}
+	return convertDatasetToModel(&d), nil
+}
+
+func (ds *DatasetService) QueryDatasets(req *dataset.QueryDatasetRequest) ([]*dataset.Dataset, int64, error) {
+	datasets, total, err := db.QueryDatasetsByProvider(req.Provider, req.Page, req.PageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	res := []*dataset.Dataset{}
+	for _, d := range datasets {
+		res = append(res, convertDatasetToModel(d))
+	}
+	return res, total, nil
+}
+
+func (ds *DatasetService) DescribeDataset(req *dataset.DescribeDatasetRequest) (*dataset.Dataset, error) {
+	d, err := db.QueryDatasetByName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convertDatasetToModel(d), nil
+}
+
+// ResolveJobDatasets checks the datasets a job declares as name or name@preview_version, the creator must be
+// allowed to use each of them. The latest preview is recorded if no version is given
+func (ds *DatasetService) ResolveJobDatasets(creator string, declarations []string) ([]*db.JobDataset, error) {
+	res := []*db.JobDataset{}
+	seen := map[string]bool{}
+	for _, declaration := range declarations {
+		name, version, err := parseDatasetDeclaration(declaration)
+		if err != nil {
+			return nil, err
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("dataset %s is declared more than once", name)
+		}
+		seen[name] = true
+		d, err := db.QueryDatasetByName(name)
+		if err != nil {
+			return nil, err
+		}
+		if err = checkDatasetUser(d, creator); err != nil {
+			return nil, err
+		}
+		preview, err := db.QueryDatasetPreview(name, version)
+		if err == nil {
+			version = preview.Version
+		} else if version > 0 || !stderrors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		res = append(res, &db.JobDataset{DatasetName: name, PreviewVersion: version})
+	}
+	return res, nil
+}
+
+func parseDatasetDeclaration(declaration string) (string, int64, error) {
+	name, version, found := strings.Cut(declaration, "@")
+	if !found {
+		return name, 0, nil
+	}
+	v, err := strconv.ParseInt(version, 10, 64)
+	if err != nil || v <= 0 {
+		return "", 0, fmt.Errorf("invalid preview version of dataset %s", declaration)
+	}
+	return name, v, nil
+}
+
+func formatDatasetDeclaration(r *db.JobDataset) string {
+	if r.PreviewVersion == 0 {
+		return r.DatasetName
+	}
+	return fmt.Sprintf("%s@%d", r.DatasetName, r.PreviewVersion)
+}
+
+// checkDatasetUser applies the creator constraint of the dataset's access policy to a notebook user
+func checkDatasetUser(d *db.Dataset, creator string) error {
+	if d.AccessPolicy == "" {
+		return nil
+	}
+	p, err := attestationpolicy.Parse([]byte(d.AccessPolicy))
+	if err != nil {
+		return err
+	}
+	if !p.AllowsCreator(creator) {
+		return fmt.Errorf("user %s is not allowed to use dataset %s", creator, d.Name)
+	}
+	return nil
+}
+
+// GetJobDatasets returns the datasets the job declared
+func (ds *DatasetService) GetJobDatasets(j *db.Job) ([]*db.Dataset, error) {
+	records, err := db.QueryJobDatasets([]string{j.UUID})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, r := range records[j.UUID] {
+		names = append(names, r.DatasetName)
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	return db.QueryDatasetsByNames(names)
+}
+
+func convertPreviewToModel(p *db.DatasetPreview) *dataset.DatasetPreview {
+	return &dataset.DatasetPreview{
+		Dataset:     p.DatasetName,
+		Version:     p.Version,
+		Kind:        p.Kind,
+		Description: p.Description,
+		Filename:    p.FileName,
+		Size:        p.Size,
+		Sha256:      p.Sha256,
+		CreatedAt:   p.CreatedAt.Format(utils.Layout),
+	}
+}
+
+type countWriter struct {
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+// UploadDatasetPreview stores the file as the next stage-1 preview of the dataset, only the provider of the
+// dataset can upload previews
+func (ds *DatasetService) UploadDatasetPreview(req *dataset.UploadDatasetPreviewRequest, file io.Reader) (*dataset.DatasetPreview, error) {
+	d, err := db.QueryDatasetByName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if d.Provider != req.Provider {
+		return nil, fmt.Errorf("dataset %s is not provided by %s", d.Name, req.Provider)
+	}
+	p := db.DatasetPreview{
+		DatasetName: d.Name,
+		Kind:        req.Kind,
+		Description: req.Description,
+		FileName:    req.Filename,
+	}
+	err = db.CreateDatasetPreview(&p)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	counter := &countWriter{}
+	provider := cloud.GetCloudProvider(ds.ctx)
+	err = provider.UploadFile(io.TeeReader(file, io.MultiWriter(hash, counter)), config.GetDatasetPreviewPath(d.Name, p.Version, p.FileName), false)
+	if err != nil {
+		if deleteErr := db.DeleteDatasetPreview(&p); deleteErr != nil {
+			hlog.Errorf("[DatasetService] failed to delete dataset preview %+v", deleteErr)
+		}
+		return nil, err
+	}
+	p.Size = counter.n
+	p.Sha256 = hex.EncodeToString(hash.Sum(nil))
+	err = db.UpdateDatasetPreview(&p)
+	if err != nil {
+		return nil, err
+	}
+	return convertPreviewToModel(&p), nil
+}
+
+func (ds *DatasetService) QueryDatasetPreviews(req *dataset.QueryDatasetPreviewRequest) ([]*dataset.DatasetPreview, error) {
+	d, err := db.QueryDatasetByName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkDatasetUser(d, req.Creator); err != nil {
+		return nil, err
+	}
+	previews, err := db.QueryDatasetPreviews(d.Name)
+	if err != nil {
+		return nil, err
+	}
+	res := []*dataset.DatasetPreview{}
+	for _, p := range previews {
+		res = append(res, convertPreviewToModel(p))
+	}
+	return res, nil
+}
+
+func (ds *DatasetService) DownloadDatasetPreview(req *dataset.DownloadDatasetPreviewRequest) (string, error) {
+	d, err := db.QueryDatasetByName(req.Name)
+	if err != nil {
+		return "", err
+	}
+	if err = checkDatasetUser(d, req.Creator); err != nil {
+		return "", err
+	}
+	p, err := db.QueryDatasetPreview(d.Name, req.Version)
+	if err != nil {
+		return "", err
+	}
+	provider := cloud.GetCloudProvider(ds.ctx)
+	data, err := provider.GetFilebyChunk(config.GetDatasetPreviewPath(d.Name, p.Version, p.FileName), req.Offset, req.Chunk)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// BindJobDatasets lets the attested image of the job decrypt its datasets, the keys are granted to the identities
+// of the workload identity pool whose image digest is the job's, no other image can use them
+func (ds *DatasetService) BindJobDatasets(j *db.Job) error {
+	datasets, err := ds.GetJobDatasets(j)
+	if err != nil {
+		return err
+	}
+	provider := cloud.GetCloudProvider(ds.ctx)
+	member := config.GetWipPrincipalSet("image_digest", j.DockerImageDigest)
+	for _, d := range datasets {
+		err = provider.GrantKeyRole(member, d.KeyId, datasetDecrypterRole)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnbindJobDatasets revokes the keys of the datasets from the job once it ends
+func (ds *DatasetService) UnbindJobDatasets(j *db.Job) {
+	if j.DockerImageDigest == "" {
+		return
+	}
+	datasets, err := ds.GetJobDatasets(j)
+	if err != nil {
+		hlog.Errorf("[DatasetService] failed to query datasets of job %s: %+v", j.UUID, err)
+		return
+	}
+	provider := cloud.GetCloudProvider(ds.ctx)
+	member := config.GetWipPrincipalSet("image_digest", j.DockerImageDigest)
+	for _, d := range datasets {
+		err = provider.RevokeKeyRole(member, d.KeyId, datasetDecrypterRole)
+		if err != nil {
+			hlog.Errorf("[DatasetService] failed to revoke key of dataset %s from job %s: %+v", d.Name, j.UUID, err)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+)
+
+// DockerBuildService builds images with the local docker daemon, it's used outside kubernetes
+type DockerBuildService struct {
+	ctx context.Context
+}
+
+func NewDockerBuildService(ctx context.Context) *DockerBuildService {
+	return &DockerBuildService{ctx: ctx}
+}
+
+func (d *DockerBuildService) BuildImage(j *db.Job, token string) error {
+	buildCtx, args, err := prepareBuild(d.ctx, j, DockerBuilder)
+	if err != nil {
+		return err
+	}
+	// the request returns once the build starts, the result is reported when it's done
+	go func() {
+		defer buildCtx.Close()
+		stop := keepBuildAlive(j)
+		digest, err := d.build(j, args, buildCtx)
+		stop()
+		reportBuildResult(j, token, digest, err)
+	}()
+	return nil
+}
+
+// keepBuildAlive touches the job until the returned function is called. A build stops with the API,
+// the launch reconciliation fails the building jobs that were not touched since Launch.StaleAfter
+func keepBuildAlive(j *db.Job) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(config.GetLaunchStaleAfter() / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := db.TouchJob(j.UUID, int(job.JobStatus_ImageBuilding)); err != nil {
+					hlog.Warnf("[DockerBuildService] failed to touch job %s: %+v", j.UUID, err)
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+func (d *DockerBuildService) build(j *db.Job, args []string, buildCtx io.Reader) (string, error) {
+	imageTag := config.GetJobDockerImageFull(j.Creator, j.UUID)
+	buildArgs := []string{"build", "--tag", imageTag}
+	for _, arg := range args {
+		buildArgs = append(buildArgs, "--build-arg", arg)
+	}
+	// read the build context from stdin
+	buildArgs = append(buildArgs, "-")
+	if _, err := runDocker(buildCtx, buildArgs...); err != nil {
+		return "", err
+	}
+	if _, err := runDocker(nil, "push", imageTag); err != nil {
+		return "", err
+	}
+	repoDigest, err := runDocker(nil, "inspect", "--format", "{{index .RepoDigests 0}}", imageTag)
+	if err != nil {
+		return "", err
+	}
+	_, digest, found := strings.Cut(strings.TrimSpace(repoDigest), "@")
+	if !found {
+		return "", fmt.Errorf("failed to get digest of %s from %s", imageTag, repoDigest)
+	}
+	hlog.Infof("[DockerBuildService] built image %s@%s", imageTag, digest)
+	return digest, nil
+}
+
+func runDocker(stdin io.Reader, args ...string) (string, error) {
+	cmd := exec.Command("docker", args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdin = stdin
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("failed to run docker %s: %s", args[0], stderr.String()))
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,439 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/egress"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/cloud"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/inspection"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/manifest"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+)
+
+const (
+	AuditQuarantineOutput = "quarantine_output"
+	AuditReleaseOutput    = "release_output"
+	AuditRedactOutput     = "redact_output"
+	AuditRejectOutput     = "reject_output"
+	// egressActor is the actor of the audit logs written by the automated checks
+	egressActor = "egress"
+)
+
+type EgressService struct {
+	ctx context.Context
+}
+
+// NewEgressService create egress service
+func NewEgressService(ctx context.Context) *EgressService {
+	return &EgressService{ctx: ctx}
+}
+
+func convertJobOutputToModel(o *db.JobOutput) *egress.JobOutput {
+	return &egress.JobOutput{
+		JobUUID:        o.JobUUID,
+		Creator:        o.Creator,
+		Filename:       o.FileName,
+		Size:           o.Size,
+		Sha256:         o.Sha256,
+		Status:         o.Status,
+		Report:         o.Report,
+		Reviewer:       o.Reviewer,
+		Comment:        o.Comment,
+		CreatedAt:      o.CreatedAt.Format(utils.Layout),
+		UpdatedAt:      o.UpdatedAt.Format(utils.Layout),
+		AttestedSha256: o.AttestedSha256,
+	}
+}
+
+func getInspectionLimits() inspection.Limits {
+	conf := config.GetEgressConfig()
+	return inspection.Limits{
+		MaxSize:      conf.MaxOutputSize,
+		MinRowCount:  conf.MinRowCount,
+		MinCellCount: conf.MinCellCount,
+		KAnonymity:   conf.KAnonymity,
+		// the comparison with the input data only runs in the TEE, the API can't read the data
+		MaxDistinctRatio: conf.MaxDistinctRatio,
+	}
+}
+
+// getAttestedManifest returns the manifest of the outputs of the job once its attestation is verified
+func (es *EgressService) getAttestedManifest(j *db.Job) (*manifest.Manifest, error) {
+	_, m, err := NewAttestationService(es.ctx).VerifyJobAttestation(j)
+	return m, err
+}
+
+// QuarantineJobOutputs inspects the outputs listed in the attested manifest of a finished job. Outputs passing
+// the checks are released right away if there are no reviewers, the others wait in quarantine for a reviewer
+func (es *EgressService) QuarantineJobOutputs(j *db.Job) error {
+	provider := cloud.GetCloudProvider(es.ctx)
+	limits := getInspectionLimits()
+	needsReview := len(config.GetEgressConfig().Reviewers) > 0
+	teeReports, err := readTeeInspectionReports(provider, j)
+	if err != nil {
+		return err
+	}
+	artifacts := []manifest.Artifact{}
+	m, manifestErr := es.getAttestedManifest(j)
+	if manifestErr != nil {
+		// without an attested manifest only the notebook is quarantined, and it's blocked
+		hlog.Warnf("[EgressService] failed to get the attested output manifest of job %s: %+v", j.UUID, manifestErr)
+		artifacts = append(artifacts, manifest.Artifact{Path: j.JupyterFileName})
+	} else {
+		artifacts = m.Artifacts
+	}
+	for _, a := range artifacts {
+		name := a.Path
+		quarantinePath := config.GetJobQuarantinePath(j.Creator, j.UUID, name)
+		size, err := provider.GetFileSize(quarantinePath)
+		if err != nil {
+			return err
+		}
+		var content []byte
+		// an output over the size limit is blocked without being read
+		if size <= limits.MaxSize {
+			content, err = provider.GetFilebyChunk(quarantinePath, 0, size)
+			if err != nil {
+				return err
+			}
+		}
+		report := inspection.Inspect(name, size, content, limits, nil)
+		sum := ""
+		if content != nil {
+			digest := sha256.Sum256(content)
+			sum = hex.EncodeToString(digest[:])
+		}
+		if manifestErr != nil {
+			report.Findings = append(report.Findings, inspection.Finding{
+				Check:    inspection.CheckIntegrity,
+				Location: name,
+				Message:  fmt.Sprintf("the output isn't in an attested manifest: %v", manifestErr),
+			})
+		} else if content != nil && sum != a.Sha256 {
+			report.Findings = append(report.Findings, inspection.Finding{
+				Check:    inspection.CheckIntegrity,
+				Location: name,
+				Message:  fmt.Sprintf("sha256 %s doesn't match %s in the attested manifest", sum, a.Sha256),
+			})
+		}
+		if teeReport, ok := teeReports[name]; ok {
+			report.Merge(teeReport)
+		} else {
+			report.Findings = append(report.Findings, inspection.Finding{
+				Check:    inspection.CheckVerbatim,
+				Location: name,
+				Message:  "the TEE didn't compare the output with the input data",
+			})
+		}
+		reportBytes, err := json.Marshal(report)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal inspection report")
+		}
+		o := &db.JobOutput{
+			JobUUID:        j.UUID,
+			Creator:        j.Creator,
+			FileName:       name,
+			Size:           size,
+			Status:         db.OutputQuarantined,
+			Report:         string(reportBytes),
+			Sha256:         sum,
+			AttestedSha256: a.Sha256,
+		}
+		if report.Blocked() {
+			o.Status = db.OutputBlocked
+		}
+		saved, err := db.UpsertJobOutput(o)
+		if err != nil {
+			return err
+		}
+		if !saved {
+			// the quarantine is retried, the output was already reviewed
+			continue
+		}
+		err = db.CreateAuditLog(&db.AuditLog{
+			JobUUID: j.UUID,
+			Actor:   egressActor,
+			Action:  AuditQuarantineOutput,
+			Detail:  fmt.Sprintf("output %s, %d findings", name, len(report.Findings)),
+		})
+		if err != nil {
+			return err
+		}
+		hlog.Infof("[EgressService] output %s of job %s is %s", name, j.UUID, o.Status)
+		if o.Status == db.OutputBlocked || needsReview {
+			continue
+		}
+		o.Status = db.OutputReleased
+		err = es.releaseJobOutput(j, o, &db.AuditLog{
+			JobUUID: j.UUID,
+			Actor:   egressActor,
+			Action:  AuditReleaseOutput,
+			Detail:  fmt.Sprintf("output %s passed the checks", name),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readTeeInspectionReports reads the reports of the inspection run in the TEE, where the outputs are compared
+// with a sample of the input data
+func readTeeInspectionReports(provider cloud.CloudProvider, j *db.Job) (map[string]*inspection.Report, error) {
+	res := map[string]*inspection.Report{}
+	content, err := readCloudFile(provider, config.GetJobInspectionReportPath(j.Creator, j.UUID))
+	if err != nil {
+		hlog.Warnf("[EgressService] failed to read the TEE inspection report of job %s: %+v", j.UUID, err)
+		return res, nil
+	}
+	reports := []*inspection.Report{}
+	if err = json.Unmarshal(content, &reports); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal TEE inspection report")
+	}
+	for _, r := range reports {
+		res[r.Artifact] = r
+	}
+	return res, nil
+}
+
+// releaseJobOutput copies the output out of quarantine to where the creator downloads it, the encrypted
+// copy of the notebook is released with it
+func (es *EgressService) releaseJobOutput(j *db.Job, o *db.JobOutput, log *db.AuditLog) error {
+	provider := cloud.GetCloudProvider(es.ctx)
+	copies := map[string]string{
+		config.GetJobQuarantinePath(j.Creator, j.UUID, o.FileName): config.GetJobOutputPath(j.Creator, j.UUID, o.FileName),
+	}
+	if o.FileName == j.JupyterFileName {
+		encryptedName := config.GetEncryptedJobOutputFilename(j.UUID, j.JupyterFileName)
+		copies[config.GetJobQuarantinePath(j.Creator, j.UUID, encryptedName)] = config.GetEncryptedJobOutputPath(j.Creator, j.UUID, j.JupyterFileName)
+	}
+	for src, dest := range copies {
+		if err := provider.CopyFile(src, dest); err != nil {
+			es.deleteReleasedFiles(copies)
+			return err
+		}
+	}
+	return es.reviewJobOutput(o, log, copies)
+}
+
+// reviewJobOutput records the review, the released files are removed again if the output was reviewed meanwhile
+func (es *EgressService) reviewJobOutput(o *db.JobOutput, log *db.AuditLog, released map[string]string) error {
+	reviewed, err := db.ReviewJobOutput(o, log)
+	if err == nil && !reviewed {
+		err = fmt.Errorf("output %s of job %s was already reviewed", o.FileName, o.JobUUID)
+	}
+	if err != nil {
+		es.deleteReleasedFiles(released)
+		return err
+	}
+	hlog.Infof("[EgressService] output %s of job %s is %s", o.FileName, o.JobUUID, o.Status)
+	return nil
+}
+
+func (es *EgressService) deleteReleasedFiles(released map[string]string) {
+	provider := cloud.GetCloudProvider(es.ctx)
+	for _, dest := range released {
+		if err := provider.DeleteFile(dest); err != nil {
+			hlog.Warnf("[EgressService] failed to delete released file %s: %+v", dest, err)
+		}
+	}
+}
+
+// CheckJobOutputReleased fails unless the output was released to the creator
+func (es *EgressService) CheckJobOutputReleased(j *db.Job, name string) error {
+	o, err := db.QueryJobOutput(j.UUID, name)
+	if err != nil {
+		return err
+	}
+	if !db.IsOutputReleased(o.Status) {
+		return fmt.Errorf("output %s of job %d is %s, it's not released", name, j.ID, o.Status)
+	}
+	return nil
+}
+
+// ListJobOutputs returns the outputs of the job with the root hash of its attested manifest
+func (es *EgressService) ListJobOutputs(j *db.Job) ([]*job.JobArtifact, string, error) {
+	outputs, err := db.QueryJobOutputs(j.UUID)
+	if err != nil {
+		return nil, "", err
+	}
+	res := []*job.JobArtifact{}
+	for _, o := range outputs {
+		res = append(res, &job.JobArtifact{
+			Filename:       o.FileName,
+			Size:           o.Size,
+			Sha256:         o.Sha256,
+			AttestedSha256: o.AttestedSha256,
+			Status:         o.Status,
+		})
+	}
+	root := ""
+	if m, err := es.getAttestedManifest(j); err == nil {
+		root = m.Root
+	} else {
+		hlog.Warnf("[EgressService] failed to get the attested output manifest of job %s: %+v", j.UUID, err)
+	}
+	return res, root, nil
+}
+
+// VerifyJobOutput hashes the released output and checks it against the attested manifest of the job,
+// redacted outputs never match it
+func (es *EgressService) VerifyJobOutput(j *db.Job, name string) (*job.VerifyJobOutputResponse, error) {
+	if err := es.CheckJobOutputReleased(j, name); err != nil {
+		return nil, err
+	}
+	provider := cloud.GetCloudProvider(es.ctx)
+	content, err := readCloudFile(provider, config.GetJobOutputPath(j.Creator, j.UUID, name))
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(content)
+	res := &job.VerifyJobOutputResponse{Sha256: hex.EncodeToString(digest[:])}
+	m, err := es.getAttestedManifest(j)
+	if err != nil {
+		res.Reason = err.Error()
+		return res, nil
+	}
+	res.ManifestRoot = m.Root
+	if a, ok := m.Get(name); ok {
+		res.AttestedSha256 = a.Sha256
+	}
+	if err = m.Verify(name, content); err != nil {
+		res.Reason = err.Error()
+		return res, nil
+	}
+	res.Verified = true
+	return res, nil
+}
+
+// getOutputToReview returns the output if the reviewer may review it, creators never review their own outputs
+func getOutputToReview(reviewer string, uuid string, name string) (*db.Job, *db.JobOutput, error) {
+	if !config.IsEgressReviewer(reviewer) {
+		return nil, nil, fmt.Errorf("%s is not an output reviewer", reviewer)
+	}
+	j, err := db.QueryJobByUUID(uuid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if j.Creator == reviewer {
+		return nil, nil, fmt.Errorf("%s can't review the outputs of its own job", reviewer)
+	}
+	o, err := db.QueryJobOutput(uuid, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return j, o, nil
+}
+
+func (es *EgressService) QueryJobOutputs(req *egress.QueryJobOutputsRequest) ([]*egress.JobOutput, int64, error) {
+	if !config.IsEgressReviewer(req.Reviewer) {
+		return nil, 0, fmt.Errorf("%s is not an output reviewer", req.Reviewer)
+	}
+	outputs, total, err := db.QueryJobOutputsToReview(req.Page, req.PageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	res := []*egress.JobOutput{}
+	for _, o := range outputs {
+		res = append(res, convertJobOutputToModel(o))
+	}
+	return res, total, nil
+}
+
+func (es *EgressService) DownloadQuarantinedOutput(req *egress.DownloadQuarantinedOutputRequest) (string, error) {
+	j, o, err := getOutputToReview(req.Reviewer, req.UUID, req.Filename)
+	if err != nil {
+		return "", err
+	}
+	provider := cloud.GetCloudProvider(es.ctx)
+	data, err := provider.GetFilebyChunk(config.GetJobQuarantinePath(j.Creator, j.UUID, o.FileName), req.Offset, req.Chunk)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+func (es *EgressService) DecideJobOutput(req *egress.DecideJobOutputRequest) error {
+	j, o, err := getOutputToReview(req.Reviewer, req.UUID, req.Filename)
+	if err != nil {
+		return err
+	}
+	o.Reviewer = req.Reviewer
+	o.Comment = req.Comment
+	log := &db.AuditLog{
+		JobUUID: j.UUID,
+		Actor:   req.Reviewer,
+		Detail:  fmt.Sprintf("output %s, comment: %s", o.FileName, req.Comment),
+	}
+	if req.Decision == "reject" {
+		o.Status = db.OutputRejected
+		log.Action = AuditRejectOutput
+		return es.reviewJobOutput(o, log, nil)
+	}
+	o.Status = db.OutputReleased
+	log.Action = AuditReleaseOutput
+	return es.releaseJobOutput(j, o, log)
+}
+
+// RedactJobOutput releases the redacted version of the output uploaded by the reviewer instead of the output
+func (es *EgressService) RedactJobOutput(req *egress.RedactJobOutputRequest, file io.Reader) (*egress.JobOutput, error) {
+	j, o, err := getOutputToReview(req.Reviewer, req.UUID, req.Filename)
+	if err != nil {
+		return nil, err
+	}
+	if o.Status != db.OutputQuarantined && o.Status != db.OutputBlocked {
+		return nil, fmt.Errorf("output %s of job %s was already reviewed", o.FileName, o.JobUUID)
+	}
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read redacted output")
+	}
+	releasedPath := config.GetJobOutputPath(j.Creator, j.UUID, o.FileName)
+	provider := cloud.GetCloudProvider(es.ctx)
+	if err = provider.UploadFile(bytes.NewReader(content), releasedPath, false); err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(content)
+	o.Status = db.OutputRedacted
+	o.Reviewer = req.Reviewer
+	o.Comment = req.Comment
+	o.Size = int64(len(content))
+	o.Sha256 = hex.EncodeToString(digest[:])
+	err = es.reviewJobOutput(o, &db.AuditLog{
+		JobUUID: j.UUID,
+		Actor:   req.Reviewer,
+		Action:  AuditRedactOutput,
+		Detail:  fmt.Sprintf("output %s, redacted sha256 %s, comment: %s", o.FileName, o.Sha256, req.Comment),
+	}, map[string]string{releasedPath: releasedPath})
+	if err != nil {
+		return nil, err
+	}
+	return convertJobOutputToModel(o), nil
+}
@@ -16,17 +16,21 @@ package service
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/jobstate"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/cloud"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+	attestationpolicy "github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/policy"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
 )
 
@@ -49,12 +53,30 @@ func (js *JobService) SubmitJob(req *job.SubmitJobRequest, userWorkspace io.Read
 		return "", errors.Wrap(fmt.Errorf(errno.ReachJobLimitErrMsg), "")
 	}
 
-	provider := cloud.GetCloudProvider(js.ctx)
-	err = provider.UploadFile(userWorkspace, config.GetUserWorkSpacePath(creator), false)
+	teeType := req.TeeType
+	if teeType == "" {
+		teeType = config.GetTEEType()
+	}
+	if _, err = config.LookupTEEType(teeType); err != nil {
+		return "", err
+	}
+	networkMode := req.NetworkMode
+	if networkMode == "" {
+		networkMode = config.GetNetworkMode()
+	}
+	if err = config.ValidateNetworkMode(networkMode); err != nil {
+		return "", err
+	}
+	profile, cpus, err := resolveMachineProfile(creator, teeType, req.Profile, jobInList)
 	if err != nil {
 		return "", err
 	}
-	err = provider.PrepareResourcesForUser(creator)
+	datasets, err := NewDatasetService(js.ctx).ResolveJobDatasets(creator, req.Datasets)
+	if err != nil {
+		return "", err
+	}
+	ps := NewPrivacyService(js.ctx)
+	budgets, err := ps.ResolveJobPrivacyBudgets(creator, datasets, req.PrivacyBudgets)
 	if err != nil {
 		return "", err
 	}
@@ -67,21 +89,102 @@ func (js *JobService) SubmitJob(req *job.SubmitJobRequest, userWorkspace io.Read
 		UUID:            uuidStr.String(),
 		Creator:         req.Creator,
 		JupyterFileName: req.JupyterFileName,
-		JobStatus:       int(job.JobStatus_ImageBuilding),
+		JobStatus:       int(job.JobStatus_Queued),
+		PolicyProvider:  req.PolicyProvider,
+		TEEType:         teeType,
+		MachineProfile:  profile.Name,
+		Cpus:            cpus,
+		Spot:            req.Spot,
+		NetworkMode:     networkMode,
 	}
-	err = BuildImage(js.ctx, t, req.AccessToken)
+	// the budget is reserved before anything else, submissions that would exceed it are rejected
+	err = ps.ReserveJobPrivacyBudgets(t.UUID, budgets)
 	if err != nil {
 		return "", err
 	}
-	err = db.CreateJob(&t)
-
+	err = js.createJob(&t, userWorkspace)
 	if err != nil {
+		ps.SettleJobPrivacyBudgets(&t)
+		return "", err
+	}
+	hlog.Infof("[JobService] inserted job. Job Status %+v", job.JobStatus_Queued)
+	// the datasets are recorded before the build, they are baked into the image
+	for _, d := range datasets {
+		d.JobUUID = t.UUID
+	}
+	err = db.CreateJobDatasets(datasets)
+	if err == nil {
+		// the job is building before the build starts, builders may report the result before BuildImage returns
+		err = transitionJob(&t, job.JobStatus_ImageBuilding)
+	}
+	if err == nil {
+		err = BuildImage(js.ctx, t, req.AccessToken)
+	}
+	if err != nil {
+		t.FailureReason = err.Error()
+		if updateErr := transitionJob(&t, job.JobStatus_ImageBuildingFailed); updateErr != nil {
+			hlog.Errorf("[JobService] failed to update job status %+v", updateErr)
+		}
+		deleteBuildContext(js.ctx, &t)
+		ps.SettleJobPrivacyBudgets(&t)
 		return "", err
 	}
-	hlog.Infof("[JobService] inserted job. Job Status %+v", job.JobStatus_ImageBuilding)
 	return uuidStr.String(), nil
 }
 
+// resolveMachineProfile checks that the user may use the profile, and that the CPUs of its machine for the TEE type
+// and those of the in progress jobs of the user fit in the quota. It returns the profile and the CPUs of its machine
+func resolveMachineProfile(creator string, teeType string, name string, inProgress []*db.Job) (config.MachineProfile, int, error) {
+	profile, err := config.GetMachineProfile(name)
+	if err != nil {
+		return profile, 0, err
+	}
+	if !profile.AllowsUser(creator) {
+		return profile, 0, fmt.Errorf("user %s is not allowed to use machine profile %s", creator, profile.Name)
+	}
+	cpus, err := config.GetMachineCpus(teeType, profile)
+	if err != nil {
+		return profile, 0, err
+	}
+	quota := config.GetMaxUserCpus()
+	if quota == 0 {
+		return profile, cpus, nil
+	}
+	used := 0
+	for _, j := range inProgress {
+		used += j.Cpus
+	}
+	if used+cpus > quota {
+		return profile, 0, errno.CpuQuotaExceededErr.WithMessage(fmt.Sprintf(
+			"machine profile %s needs %d CPUs with TEE type %s, %d of the %d CPUs of user %s are in use",
+			profile.Name, cpus, teeType, used, quota, creator))
+	}
+	return profile, cpus, nil
+}
+
+// createJob uploads the workspace of the job and stores the job
+func (js *JobService) createJob(t *db.Job, userWorkspace io.Reader) error {
+	provider := cloud.GetCloudProvider(js.ctx)
+	err := provider.UploadFile(userWorkspace, config.GetUserWorkSpacePath(t.Creator), false)
+	if err != nil {
+		return err
+	}
+	err = provider.PrepareResourcesForUser(t.Creator)
+	if err != nil {
+		return err
+	}
+	if t.PolicyProvider != "" {
+		// the job runs under the policy version that is the latest one when it's submitted
+		p, err := db.QueryPolicy(t.PolicyProvider, 0)
+		if err != nil {
+			return err
+		}
+		t.PolicyVersion = p.Version
+	}
+	// the job is stored first, builders may report the result before BuildImage returns
+	return db.CreateJob(t)
+}
+
 func convertEntityToModel(j *db.Job) *job.Job {
 	return &job.Job{
 		ID:              int64(j.ID),
@@ -91,6 +194,15 @@ func convertEntityToModel(j *db.Job) *job.Job {
 		JupyterFileName: j.JupyterFileName,
 		CreatedAt:       j.CreatedAt.Format(utils.Layout),
 		UpdatedAt:       j.UpdatedAt.Format(utils.Layout),
+		FailureReason:   j.FailureReason,
+		PolicyProvider:  j.PolicyProvider,
+		PolicyVersion:   j.PolicyVersion,
+		TeeType:         j.TEEType,
+		Profile:         j.MachineProfile,
+		Spot:            j.Spot,
+		Attempts:        int32(j.Attempts),
+		Zone:            j.Zone,
+		NetworkMode:     j.NetworkMode,
 	}
 }
 
@@ -99,26 +211,57 @@ func (js *JobService) QueryUsersJobs(req *job.QueryJobRequest) ([]*job.Job, int6
 	if err != nil {
 		return nil, 0, err
 	}
+	UUIDs := []string{}
+	for _, j := range jobs {
+		UUIDs = append(UUIDs, j.UUID)
+	}
+	datasets, err := db.QueryJobDatasets(UUIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	budgets, err := db.QueryJobPrivacyLedger(UUIDs)
+	if err != nil {
+		return nil, 0, err
+	}
 	res := []*job.Job{}
 	for _, j := range jobs {
-		res = append(res, convertEntityToModel(j))
+		m := convertEntityToModel(j)
+		for _, d := range datasets[j.UUID] {
+			m.Datasets = append(m.Datasets, formatDatasetDeclaration(d))
+		}
+		for _, e := range budgets[j.UUID] {
+			m.PrivacyBudgets = append(m.PrivacyBudgets, formatPrivacyBudgetRequest(e))
+		}
+		res = append(res, m)
 	}
 	return res, total, nil
 }
 
+// getOutputName returns the requested output of the job, the executed notebook if none is requested
+func getOutputName(j *db.Job, filename string) string {
+	if filename == "" {
+		return j.JupyterFileName
+	}
+	return filename
+}
+
 func (js *JobService) GetJobOutputAttrs(req *job.QueryJobOutputRequest) (string, int64, error) {
 	j, err := db.QueryJobByIdAndCreator(req.ID, req.Creator)
 	if err != nil {
 		return "", 0, err
 	}
-	outputPath := config.GetJobOutputPath(j.Creator, j.UUID, j.JupyterFileName)
+	name := getOutputName(j, req.Filename)
+	if err = NewEgressService(js.ctx).CheckJobOutputReleased(j, name); err != nil {
+		return "", 0, err
+	}
+	outputPath := config.GetJobOutputPath(j.Creator, j.UUID, name)
 
 	provider := cloud.GetCloudProvider(js.ctx)
 	size, err := provider.GetFileSize(outputPath)
 	if err != nil {
 		return "", 0, err
 	}
-	return config.GetJobOutputFilename(fmt.Sprintf("%v", j.ID), j.JupyterFileName), size, nil
+	return config.GetJobOutputFilename(fmt.Sprintf("%v", j.ID), name), size, nil
 }
 
 func (js *JobService) DownloadJobOutput(req *job.DownloadJobOutputRequest) (string, error) {
@@ -126,7 +269,11 @@ func (js *JobService) DownloadJobOutput(req *job.DownloadJobOutputRequest) (stri
 	if err != nil {
 		return "", err
 	}
-	outputPath := config.GetJobOutputPath(j.Creator, j.UUID, j.JupyterFileName)
+	name := getOutputName(j, req.Filename)
+	if err = NewEgressService(js.ctx).CheckJobOutputReleased(j, name); err != nil {
+		return "", err
+	}
+	outputPath := config.GetJobOutputPath(j.Creator, j.UUID, name)
 	provider := cloud.GetCloudProvider(js.ctx)
 	datg, err := provider.GetFilebyChunk(outputPath, req.Offset, req.Chunk)
 	if err != nil {
@@ -136,49 +283,400 @@ func (js *JobService) DownloadJobOutput(req *job.DownloadJobOutputRequest) (stri
 	return encoded, nil
 }
 
+func (js *JobService) ListJobOutputs(req *job.ListJobOutputsRequest) ([]*job.JobArtifact, string, error) {
+	j, err := db.QueryJobByIdAndCreator(req.ID, req.Creator)
+	if err != nil {
+		return nil, "", err
+	}
+	return NewEgressService(js.ctx).ListJobOutputs(j)
+}
+
+func (js *JobService) VerifyJobOutput(req *job.VerifyJobOutputRequest) (*job.VerifyJobOutputResponse, error) {
+	j, err := db.QueryJobByIdAndCreator(req.ID, req.Creator)
+	if err != nil {
+		return nil, err
+	}
+	return NewEgressService(js.ctx).VerifyJobOutput(j, req.Filename)
+}
+
 func (js *JobService) DeleteJob(req *job.DeleteJobRequest) {
 	db.DeleteJob(req.Creator, req.UUID)
 }
 
+// CancelJob cancels a job in progress of the creator, stopping its build or its VM
+func (js *JobService) CancelJob(req *job.CancelJobRequest) error {
+	j, err := db.QueryJobByUUIDAndCreator(req.Creator, req.UUID)
+	if err != nil {
+		return err
+	}
+	hlog.Infof("[JobService] cancel job %s in status %s", j.UUID, job.JobStatus(j.JobStatus))
+	return js.endJob(j, job.JobStatus_Cancelled, "the job was cancelled")
+}
+
+// endJob moves a job in progress to an ended status, deletes its build job or its VM and releases its resources.
+// A VM whose zone isn't known yet is deleted by the monitor once it sees the job ended
+func (js *JobService) endJob(j *db.Job, to job.JobStatus, reason string) error {
+	from := job.JobStatus(j.JobStatus)
+	j.FailureReason = reason
+	if err := transitionJob(j, to); err != nil {
+		return err
+	}
+	if from == job.JobStatus_ImageBuilding {
+		deleteBuildJob(js.ctx, j)
+		deleteBuildContext(js.ctx, j)
+	}
+	if j.InstanceName != "" && j.Zone != "" {
+		provider := cloud.GetCloudProvider(js.ctx)
+		if err := provider.DeleteInstance(j.Zone, j.InstanceName); err != nil {
+			hlog.Errorf("[JobService] failed to delete instance %s of job %s: %+v", j.InstanceName, j.UUID, err)
+		}
+	}
+	js.releaseJobResources(j)
+	return nil
+}
+
 func (js *JobService) UpdateJob(req *job.UpdateJobStatusRequest) error {
 	creator := req.Creator
 	j, err := db.QueryJobByUUIDAndCreator(creator, req.UUID)
 	if err != nil {
 		return err
 	}
-	j.JobStatus = int(req.Status)
-	if j.JobStatus == int(job.JobStatus_VMWaiting) {
-		j.DockerImage = req.DockerImage
-		j.DockerImageDigest = req.DockerImageDigest
-		j.InstanceName = config.GetInstanceName(j.Creator, j.UUID)
-		err := js.RunJob(js.ctx, j, req.AccessToken)
-		if err != nil {
-			return err
-		}
-		j.JobStatus = int(job.JobStatus_VMRunning)
+	if req.Status == job.JobStatus_VMWaiting && j.DockerImageDigest != "" && j.DockerImageDigest == req.DockerImageDigest {
+		// the monitor reports the image again if it didn't get the answer, only the first report starts the job.
+		// A start interrupted by a restart of the API is completed by the launch reconciliation
+		hlog.Infof("[JobService] job %s was already started, ignore the report", j.UUID)
+		return nil
 	}
-	if j.JobStatus == int(job.JobStatus_VMFinished) {
+	if req.Status == job.JobStatus(j.JobStatus) {
+		// the monitor reports the status again if it didn't get the answer or failed to clean up after it
+		hlog.Infof("[JobService] job %s is already %s, ignore the report", j.UUID, req.Status)
+		return nil
+	}
+	if err = jobstate.Check(j.UUID, job.JobStatus(j.JobStatus), req.Status); err != nil {
+		return err
+	}
+	if j.JobStatus == int(job.JobStatus_ImageBuilding) {
+		// the build is over whatever its result, its context isn't read anymore
+		defer deleteBuildContext(js.ctx, j)
+	}
+	switch req.Status {
+	case job.JobStatus_VMPreempted:
+		return js.requeueJob(j, req.AccessToken)
+	case job.JobStatus_VMWaiting:
+		return js.startJob(j, req)
+	}
+	if req.FailureReason != "" {
+		j.FailureReason = req.FailureReason
+	}
+	if req.Status == job.JobStatus_VMFinished {
 		j.AttestationReport = req.AttestationToken
-		j.JobStatus = int(job.JobStatus_VMFinished)
+		j.Quarantine = db.QuarantinePending
 	}
-	err = db.UpdateJob(j)
+	err = transitionJob(j, req.Status)
 	if err != nil {
 		return err
 	}
+	if j.JobStatus == int(job.JobStatus_VMFinished) {
+		// outputs wait in quarantine until they pass the checks or a reviewer releases them,
+		// a failed quarantine is retried by the launch reconciliation
+		if err = js.quarantineJobOutputs(j); err != nil {
+			hlog.Errorf("[JobService] failed to quarantine outputs of job %s: %+v", j.UUID, err)
+		}
+	}
+	if jobstate.IsEnded(job.JobStatus(j.JobStatus)) {
+		js.releaseJobResources(j)
+	}
 	return nil
 }
 
+// quarantineJobOutputs quarantines the outputs of the finished job and records that they all are
+func (js *JobService) quarantineJobOutputs(j *db.Job) error {
+	if err := NewEgressService(js.ctx).QuarantineJobOutputs(j); err != nil {
+		return err
+	}
+	j.Quarantine = db.QuarantineDone
+	return db.UpdateJob(j)
+}
+
+// startJob accepts the built image of the job, then runs the job or waits for the approvals of the providers
+func (js *JobService) startJob(j *db.Job, req *job.UpdateJobStatusRequest) error {
+	j.DockerImage = req.DockerImage
+	j.DockerImageDigest = req.DockerImageDigest
+	j.InstanceName = config.GetInstanceName(j.Creator, j.UUID)
+	// the image is recorded with the transition, a concurrent report of the same image is rejected by the version
+	if err := transitionJob(j, job.JobStatus_VMWaiting); err != nil {
+		return err
+	}
+	err := js.AcceptImage(j)
+	pending := false
+	if err == nil {
+		// providers requiring approval review the accepted image before it can run on their data
+		pending, err = NewApprovalService(js.ctx).RequestApprovals(j)
+	}
+	if err == nil && !pending {
+		err = js.RunJob(js.ctx, j, req.AccessToken)
+	}
+	if err != nil {
+		// the job can't run, keep the reason on it so the user sees why
+		js.failJob(j, err)
+		return err
+	}
+	if pending {
+		return transitionJob(j, job.JobStatus_PendingApproval)
+	}
+	return transitionJob(j, job.JobStatus_VMRunning)
+}
+
+// transitionJob moves the job to the status through the state machine. The job is saved with the transition,
+// which fails if another request updated the job since it was read
+func transitionJob(j *db.Job, to job.JobStatus) error {
+	from := j.JobStatus
+	if err := jobstate.Check(j.UUID, job.JobStatus(from), to); err != nil {
+		return err
+	}
+	j.JobStatus = int(to)
+	if err := db.UpdateJobStatus(j, from); err != nil {
+		j.JobStatus = from
+		return err
+	}
+	return nil
+}
+
+// failJob marks the job as failed with the reason and releases its resources
+func (js *JobService) failJob(j *db.Job, reason error) {
+	j.FailureReason = reason.Error()
+	if err := transitionJob(j, job.JobStatus_VMFailed); err != nil {
+		hlog.Errorf("[JobService] failed to update job status %+v", err)
+	}
+	js.releaseJobResources(j)
+}
+
+// releaseJobResources revokes the attested access of a job once it ends and settles its privacy budget
+func (js *JobService) releaseJobResources(j *db.Job) {
+	provider := cloud.GetCloudProvider(js.ctx)
+	err := provider.DeleteWorkloadIdentityPoolProvider(config.GetJobWipProvider(j.UUID))
+	if err != nil {
+		hlog.Errorf("[JobService] failed to delete workload identity pool provider of job %s: %+v", j.UUID, err)
+	}
+	NewDatasetService(js.ctx).UnbindJobDatasets(j)
+	NewPrivacyService(js.ctx).SettleJobPrivacyBudgets(j)
+	outcome := db.AttemptFailed
+	if j.JobStatus == int(job.JobStatus_VMFinished) {
+		outcome = db.AttemptFinished
+	}
+	if err := db.EndJobAttempt(j.UUID, j.Attempts, outcome, j.FailureReason); err != nil {
+		hlog.Errorf("[JobService] failed to end attempt %d of job %s: %+v", j.Attempts, j.UUID, err)
+	}
+}
+
+// AcceptImage checks the reported digest against the registry, then publishes the provenance and signs the image
+func (js *JobService) AcceptImage(j *db.Job) error {
+	provider := cloud.GetCloudProvider(js.ctx)
+	// never trust the reported digest blindly, it must match the manifest in the registry
+	registryDigest, err := provider.GetImageDigest(config.GetJobDockerImageFull(j.Creator, j.UUID))
+	if err != nil {
+		return err
+	}
+	if registryDigest != j.DockerImageDigest {
+		return fmt.Errorf("image digest %s doesn't match registry digest %s", j.DockerImageDigest, registryDigest)
+	}
+	err = NewProvenanceService(js.ctx).PublishProvenance(j)
+	if err != nil {
+		return err
+	}
+	return NewSigningService(js.ctx).SignImage(j)
+}
+
 func (js *JobService) RunJob(c context.Context, j *db.Job, token string) error {
 	hlog.Infof("[JobSerive] docker image stored in DB, run the job. Job status: %+v", job.JobStatus_VMWaiting)
 	provider := cloud.GetCloudProvider(js.ctx)
-	provider.UpdateWorkloadIdentityPoolProvider(config.GetUserWipProvider(j.Creator), j.DockerImageDigest)
-	err := provider.CreateConfidentialSpace(j.InstanceName, j.DockerImage, token, j.UUID)
+	signingKeyId, err := NewSigningService(js.ctx).VerifyImage(j)
+	if err != nil {
+		return err
+	}
+	policyCondition, err := js.compileJobPolicy(j)
+	if err != nil {
+		return err
+	}
+	err = provider.CreateWorkloadIdentityPoolProvider(config.GetJobWipProvider(j.UUID), j.DockerImageDigest, signingKeyId, policyCondition)
+	if err != nil {
+		return err
+	}
+	err = NewDatasetService(js.ctx).BindJobDatasets(j)
+	if err != nil {
+		return err
+	}
+	return js.launchJob(j, token)
+}
+
+// launchJob persists the intent to launch the next attempt of the job before it creates its confidential VM,
+// so the launch reconciliation can complete it if the API stops in between
+func (js *JobService) launchJob(j *db.Job, token string) error {
+	j.Attempts++
+	j.InstanceName = config.GetInstanceAttemptName(j.Creator, j.UUID, j.Attempts)
+	err := db.CreateJobAttempt(&db.JobAttempt{
+		JobUUID:      j.UUID,
+		Attempt:      j.Attempts,
+		InstanceName: j.InstanceName,
+		Spot:         j.Spot,
+		Outcome:      db.AttemptLaunching,
+	})
+	if err != nil {
+		return err
+	}
+	if err = db.UpdateJob(j); err != nil {
+		return err
+	}
+	return js.resumeLaunch(j, token)
+}
+
+// resumeLaunch creates the confidential VM of the launching attempt of the job in the first candidate zone with
+// capacity. The instance name of an attempt is deterministic and an existing instance is not created again,
+// so resuming a launch never creates a second VM
+func (js *JobService) resumeLaunch(j *db.Job, token string) error {
+	profile, err := config.GetMachineProfile(j.MachineProfile)
 	if err != nil {
 		return err
 	}
+	spec := cloud.InstanceSpec{Creator: j.Creator, TEEType: j.TEEType, Profile: profile, Spot: j.Spot, NetworkMode: j.NetworkMode}
+	zone, err := cloud.GetCloudProvider(js.ctx).CreateConfidentialSpace(j.InstanceName, j.DockerImage, token, j.UUID, spec)
+	if err != nil {
+		if endErr := db.EndJobAttempt(j.UUID, j.Attempts, db.AttemptFailed, err.Error()); endErr != nil {
+			hlog.Errorf("[JobService] failed to end attempt %d of job %s: %+v", j.Attempts, j.UUID, endErr)
+		}
+		return err
+	}
+	j.Zone = zone
+	// the VM is running, a missing record must not fail the job
+	if err = db.StartJobAttempt(j.UUID, j.Attempts, zone); err != nil {
+		hlog.Errorf("[JobService] failed to record attempt %d of job %s: %+v", j.Attempts, j.UUID, err)
+	}
 	return nil
 }
 
+// requeueJob relaunches the same image after the VM of a running spot job was preempted,
+// the job fails once it used all its attempts
+func (js *JobService) requeueJob(j *db.Job, token string) error {
+	err := transitionJob(j, job.JobStatus_VMPreempted)
+	if err != nil {
+		return err
+	}
+	err = db.EndJobAttempt(j.UUID, j.Attempts, db.AttemptPreempted, "the VM was preempted")
+	if err != nil {
+		return err
+	}
+	if !j.Spot || j.Attempts >= config.GetMaxSpotAttempts() {
+		js.failJob(j, fmt.Errorf("the VM of the job was preempted after %d attempts", j.Attempts))
+		return nil
+	}
+	hlog.Infof("[JobService] VM of job %s was preempted, launch attempt %d", j.UUID, j.Attempts+1)
+	if err = js.launchJob(j, token); err != nil {
+		js.failJob(j, err)
+		return err
+	}
+	return transitionJob(j, job.JobStatus_VMRunning)
+}
+
+// compileJobPolicy compiles the policy of the job's policy provider, if any, and the access policies of the datasets
+// it declared against the recorded build inputs of the job.
+// The attestation must always come from the confidential instance type and the network mode of the job
+func (js *JobService) compileJobPolicy(j *db.Job) (string, error) {
+	teeType, err := config.LookupTEEType(j.TEEType)
+	if err != nil {
+		return "", err
+	}
+	instanceCondition := fmt.Sprintf("assertion.hwmodel == '%s' && assertion.submods.container.env_override.NETWORK_MODE == '%s'",
+		teeType.HardwareModel, j.NetworkMode)
+	inputs, err := readBuildInputs(cloud.GetCloudProvider(js.ctx), j)
+	if err != nil {
+		return "", err
+	}
+	baseImageRepository, baseImageDigest, _ := strings.Cut(inputs.BuildArgs["BASE_IMAGE"], "@")
+	condition, err := NewPolicyService(js.ctx).CompileJobPolicy(j, attestationpolicy.JobFacts{
+		Creator:             j.Creator,
+		BaseImageRepository: baseImageRepository,
+		BaseImageDigest:     baseImageDigest,
+		HardwareModel:       teeType.HardwareModel,
+		NetworkMode:         j.NetworkMode,
+	})
+	if err != nil || condition == "" {
+		return instanceCondition, err
+	}
+	return instanceCondition + " && " + condition, nil
+}
+
+// VerifyJobAttestation reports whether the attestation token of the job commits to the job, its image, datasets
+// and outputs. The commitment is returned even if the verification failed
+func (js *JobService) VerifyJobAttestation(req *job.VerifyJobAttestationRequest) (*job.VerifyJobAttestationResponse, error) {
+	j, err := db.QueryJobByIdAndCreator(req.ID, req.Creator)
+	if err != nil {
+		return nil, err
+	}
+	res := &job.VerifyJobAttestationResponse{}
+	c, _, err := NewAttestationService(js.ctx).VerifyJobAttestation(j)
+	if err != nil {
+		res.Reason = err.Error()
+		content, readErr := readCloudFile(cloud.GetCloudProvider(js.ctx), config.GetJobCommitmentPath(j.Creator, j.UUID))
+		if readErr == nil {
+			res.Commitment = string(content)
+		}
+		return res, nil
+	}
+	res.Verified = true
+	res.Nonce, err = c.Nonce()
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(c)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal commitment")
+	}
+	res.Commitment = string(content)
+	return res, nil
+}
+
+func (js *JobService) QueryJobAttempts(req *job.QueryJobAttemptsRequest) ([]*job.JobAttempt, error) {
+	j, err := db.QueryJobByIdAndCreator(req.ID, req.Creator)
+	if err != nil {
+		return nil, err
+	}
+	attempts, err := db.QueryJobAttempts(j.UUID)
+	if err != nil {
+		return nil, err
+	}
+	res := []*job.JobAttempt{}
+	for _, a := range attempts {
+		m := &job.JobAttempt{
+			Attempt:      int32(a.Attempt),
+			InstanceName: a.InstanceName,
+			Spot:         a.Spot,
+			Outcome:      a.Outcome,
+			Reason:       a.Reason,
+			StartedAt:    a.CreatedAt.Format(utils.Layout),
+			Zone:         a.Zone,
+		}
+		if a.Outcome != db.AttemptRunning {
+			m.EndedAt = a.UpdatedAt.Format(utils.Layout)
+		}
+		res = append(res, m)
+	}
+	return res, nil
+}
+
+// QueryJobState returns the state of a job the monitor needs to handle the TEE instance of the job
+func (js *JobService) QueryJobState(req *job.QueryJobStateRequest) (*job.QueryJobStateResponse, error) {
+	j, err := db.QueryJobByUUID(req.UUID)
+	if err != nil {
+		return nil, err
+	}
+	return &job.QueryJobStateResponse{
+		Creator:      j.Creator,
+		JobStatus:    job.JobStatus(j.JobStatus),
+		InstanceName: j.InstanceName,
+		Ended:        jobstate.IsEnded(job.JobStatus(j.JobStatus)),
+	}, nil
+}
+
 func (js *JobService) GetJobAttestationReport(req *job.QueryJobAttestationRequest) (string, error) {
 	j, err := db.QueryJobByIdAndCreator(req.ID, req.Creator)
 	if err != nil {
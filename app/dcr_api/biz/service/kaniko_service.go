@@ -17,22 +17,12 @@ package service
 import (
 	"context"
 	"fmt"
-	"io"
-	"os"
-	"path/filepath"
 
-	"github.com/docker/docker/pkg/archive"
-	"github.com/pkg/errors"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
-	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/cloud"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
-	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
-	resource "k8s.io/apimachinery/pkg/api/resource"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"
 )
 
 type KubernetesBuildService struct {
@@ -49,111 +39,31 @@ func NewKanikoService(ctx context.Context) *KubernetesBuildService {
 	return buildService
 }
 
-func (k *KubernetesBuildService) CreateBuildCtx(ctx context.Context, creator string) (io.ReadCloser, error) {
-	provider := cloud.GetCloudProvider(k.ctx)
-	workingDir, err := utils.GetWorkDirectory()
-	if err != nil {
-		return nil, err
-	}
-	// change working dir to parent
-	err = os.Chdir(workingDir)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to change work directory")
-	}
-	// create directory for the user
-	directory := filepath.Clean(creator)
-	// remove old user directory
-	err = os.RemoveAll(directory)
-	if err != nil {
-		if !os.IsNotExist(err) {
-			return nil, errors.Wrap(err, "failed to remove user directory")
-		}
-	}
-	// create user directory
-	err = os.MkdirAll(directory, 0700)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create directory for user")
-	}
-	userWorkspaceTar := filepath.Join(directory, config.GetUserWorkspaceFile(creator))
-	// download user's workspace, it's tar.gz file
-	if err = provider.DownloadFile(config.GetUserWorkSpacePath(creator), userWorkspaceTar); err != nil {
-		return nil, err
-	}
-	// unzip the user's workspace
-	if err = utils.UnTarGz(userWorkspaceTar, directory); err != nil {
-		return nil, err
-	}
-	dockerFile := utils.GetDockerFile()
-	// copy dockerfile to directory
-	err = utils.CopyFile(dockerFile, fmt.Sprintf("%s/Dockerfile", directory))
-	if err != nil {
-		return nil, err
-	}
-	configPath := utils.GetConfigFile()
-	if err != nil {
-		return nil, err
-	}
-	// copy config file to directory
-	err = utils.CopyFile(configPath, fmt.Sprintf("%s/config.yaml", directory))
-	if err != nil {
-		return nil, err
-	}
-
-	buildCtx, err := archive.TarWithOptions(directory, &archive.TarOptions{})
-	if err != nil {
-		return nil, err
-	}
-	return buildCtx, nil
-}
-
 func (k *KubernetesBuildService) BuildImage(j *db.Job, token string) error {
 	UUID := j.UUID
 	creator := j.Creator
 	imageTag := config.GetJobDockerImageFull(creator, UUID)
-	buildCtx, err := k.CreateBuildCtx(k.ctx, creator)
+	buildCtx, args, err := prepareBuild(k.ctx, j, KanikoBuilder)
 	if err != nil {
 		return err
 	}
-	defer buildCtx.Close()
-	provider := cloud.GetCloudProvider(k.ctx)
-	// upload build context
-	err = provider.UploadFile(buildCtx, config.GetBuildContextPath(creator, UUID), true)
+	err = uploadBuildCtx(k.ctx, buildCtx, creator, UUID)
 	if err != nil {
 		return err
 	}
-
-	clusterConfig, err := rest.InClusterConfig()
-	if err != nil {
-		return errors.Wrap(err, "failed to init cluster config")
-	}
-	clientSet, err := kubernetes.NewForConfig(clusterConfig)
-	if err != nil {
-		return errors.Wrap(err, "failed to create kubernetes client set")
-	}
-	kanikoJobName := fmt.Sprintf("kaniko-%s", UUID)
-	trustedServiceAccountEmail, err := provider.GetServiceAccountEmail()
+	clientSet, err := newKubernetesClientSet()
 	if err != nil {
 		return err
 	}
+	kanikoJobName := getBuildJobName(KanikoBuilder, UUID)
 	buildArgs := []string{
 		fmt.Sprintf("--context=%s", config.GetCloudStoragePath(config.GetBuildContextPath(creator, UUID))),
 		fmt.Sprintf("--destination=%s", imageTag),
-		fmt.Sprintf("--build-arg=CREATOR=%s", creator),
-		fmt.Sprintf("--build-arg=OUTPUTPATH=%s", config.GetCloudStoragePath(config.GetJobOutputPath(creator, UUID, j.JupyterFileName))),
-		fmt.Sprintf("--build-arg=ENCRYPTED_FILENAME=%s", config.GetEncryptedJobOutputFilename(UUID, j.JupyterFileName)),
-		fmt.Sprintf("--build-arg=ENCRYPTED_CLOUDSTORAGE_PATH=%s", config.GetCloudStoragePath(config.GetEncryptedJobOutputPath(creator, UUID, j.JupyterFileName))),
-		fmt.Sprintf("--build-arg=JUPYTER_FILENAME=%s", j.JupyterFileName),
-		fmt.Sprintf("--build-arg=USER_WORKSPACE=%s", config.GetUserWorkSpaceDir(creator)),
-		fmt.Sprintf("--build-arg=BASE_IMAGE=%s", config.GetBaseDockerImage()),
-		fmt.Sprintf("--build-arg=CUSTOMTOKEN_CLOUDSTORAGE_PATH=%s", config.GetCloudStoragePath(config.GetCustomTokenPath(creator, UUID))),
-		fmt.Sprintf("--build-arg=IMPERSONATION_SERVICE_ACCOUNT=%s", trustedServiceAccountEmail),
 	}
-	annotations := map[string]string{
-		"USER_TOKEN":  token,
-		"JOB_UUID":    UUID,
-		"JOB_CREATOR": creator,
+	for _, arg := range args {
+		buildArgs = append(buildArgs, fmt.Sprintf("--build-arg=%s", arg))
 	}
-	err = k.createBuildJob(clientSet, kanikoJobName, buildArgs, annotations)
+	err = k.createBuildJob(clientSet, kanikoJobName, buildArgs, getBuildJobAnnotations(j, token))
 	if err != nil {
 		return err
 	}
@@ -161,49 +71,35 @@ func (k *KubernetesBuildService) BuildImage(j *db.Job, token string) error {
 }
 
 func (k *KubernetesBuildService) createBuildJob(clientSet *kubernetes.Clientset, jobName string, buildArgs []string, annotations map[string]string) error {
-	memQuantity, err := resource.ParseQuantity("6000M")
-	if err != nil {
-		return errors.Wrap(err, "failed to parse mem quantity")
-	}
-	ttlSecondsAfterFinished := int32(3600 * 24)
-	jobClient := clientSet.BatchV1().Jobs(k.namespace)
-	kanikoJob := &batchv1.Job{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        jobName,
-			Namespace:   k.namespace,
-			Annotations: annotations,
-		},
-		Spec: batchv1.JobSpec{
-			TTLSecondsAfterFinished: &ttlSecondsAfterFinished,
-			Template: corev1.PodTemplateSpec{
-				Spec: corev1.PodSpec{
-					ServiceAccountName: config.GetK8sPodServiceAccount(),
-					Containers: []corev1.Container{
-						{
-							Name:  "kaniko",
-							Image: "gcr.io/kaniko-project/executor:latest",
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceMemory: memQuantity,
-								},
-							},
-							Args: append([]string{
-								"--dockerfile=Dockerfile",
-								"--reproducible",
-								"--compressed-caching=false",
-								"--cache=true",
-								"--cache-ttl=72h",
-							}, buildArgs...),
-						},
-					},
-					RestartPolicy: "Never",
+	builderConfig := config.GetBuilderConfig()
+	allowPrivilegeEscalation := false
+	podSpec := corev1.PodSpec{
+		ServiceAccountName: config.GetK8sPodServiceAccount(),
+		Containers: []corev1.Container{
+			{
+				Name:  utils.BuildContainerName,
+				Image: builderConfig.KanikoImage,
+				Args: append([]string{
+					"--dockerfile=Dockerfile",
+					"--reproducible",
+					"--compressed-caching=false",
+					"--cache=true",
+					fmt.Sprintf("--cache-ttl=%s", builderConfig.CacheTTL),
+					// the digest is picked up by the monitor from the pod status
+					"--digest-file=/dev/termination-log",
+				}, buildArgs...),
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: &allowPrivilegeEscalation,
 				},
+				TerminationMessagePath:   "/dev/termination-log",
+				TerminationMessagePolicy: corev1.TerminationMessageReadFile,
 			},
 		},
+		RestartPolicy: "Never",
 	}
-	_, err = jobClient.Create(k.ctx, kanikoJob, metav1.CreateOptions{})
+	kanikoJob, err := newBuildJob(jobName, k.namespace, KanikoBuilder, annotations, podSpec)
 	if err != nil {
-		return errors.Wrap(err, "failed to create kubernetes job")
+		return err
 	}
-	return nil
+	return createKubernetesJob(k.ctx, clientSet, k.namespace, kanikoJob)
 }
@@ -0,0 +1,221 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/jobstate"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+)
+
+// LaunchService completes the job launches the API didn't complete, such as when it restarted while
+// a VM was being created. The launching attempts of the jobs are the persisted intents it resumes
+type LaunchService struct {
+	ctx context.Context
+}
+
+func NewLaunchService(ctx context.Context) *LaunchService {
+	return &LaunchService{ctx: ctx}
+}
+
+// StartLaunchReconciler reconciles the launches in the background until the context is done
+func StartLaunchReconciler(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(config.GetLaunchReconcileInterval())
+		defer ticker.Stop()
+		for {
+			NewLaunchService(ctx).Reconcile()
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Reconcile fails the local image builds that stopped with the API, times out the jobs pending for too long,
+// retries the failed quarantines of outputs, resumes the stale launching attempts, then settles the jobs that
+// stayed waiting for their VM, either to start or to be relaunched after a preemption
+func (ls *LaunchService) Reconcile() {
+	before := time.Now().Add(-config.GetLaunchStaleAfter())
+	ls.failOrphanedBuilds(before)
+	ls.timeoutPendingJobs(time.Now().Add(-config.GetLaunchPendingTimeout()))
+	ls.retryQuarantines(before)
+	attempts, err := db.QueryStaleJobAttempts(db.AttemptLaunching, before)
+	if err != nil {
+		hlog.Errorf("[LaunchService] failed to query launching attempts: %+v", err)
+		return
+	}
+	for _, a := range attempts {
+		if err = ls.resumeAttempt(a); err != nil {
+			hlog.Errorf("[LaunchService] failed to resume attempt %d of job %s: %+v", a.Attempt, a.JobUUID, err)
+		}
+	}
+	for _, status := range []job.JobStatus{job.JobStatus_VMWaiting, job.JobStatus_VMPreempted} {
+		jobs, err := db.QueryStaleJobs(int(status), before)
+		if err != nil {
+			hlog.Errorf("[LaunchService] failed to query %s jobs: %+v", status, err)
+			continue
+		}
+		for _, j := range jobs {
+			if err = ls.settleWaitingJob(j); err != nil {
+				hlog.Errorf("[LaunchService] failed to settle job %s: %+v", j.UUID, err)
+			}
+		}
+	}
+}
+
+// failOrphanedBuilds fails the jobs building their image with the local docker daemon that were not touched
+// since before, their build stopped with the API. The builds in the cluster are followed by the monitor
+func (ls *LaunchService) failOrphanedBuilds(before time.Time) {
+	if getBuilderType() != DockerBuilder {
+		return
+	}
+	jobs, err := db.QueryStaleJobs(int(job.JobStatus_ImageBuilding), before)
+	if err != nil {
+		hlog.Errorf("[LaunchService] failed to query building jobs: %+v", err)
+		return
+	}
+	for _, j := range jobs {
+		hlog.Infof("[LaunchService] image build of job %s stopped since %v, fail it", j.UUID, j.UpdatedAt)
+		j.FailureReason = "the image build was interrupted"
+		if err = transitionJob(j, job.JobStatus_ImageBuildingFailed); err != nil {
+			if err = ignoreConflict(err); err != nil {
+				hlog.Errorf("[LaunchService] failed to fail job %s: %+v", j.UUID, err)
+			}
+			continue
+		}
+		NewJobService(ls.ctx).releaseJobResources(j)
+	}
+}
+
+// timeoutPendingJobs times out the jobs queued, building their image or waiting for approvals that were not
+// touched since before, so they don't hold the quota and the datasets of their creator forever
+func (ls *LaunchService) timeoutPendingJobs(before time.Time) {
+	for _, status := range []job.JobStatus{job.JobStatus_Queued, job.JobStatus_ImageBuilding, job.JobStatus_PendingApproval} {
+		jobs, err := db.QueryStaleJobs(int(status), before)
+		if err != nil {
+			hlog.Errorf("[LaunchService] failed to query %s jobs: %+v", status, err)
+			continue
+		}
+		for _, j := range jobs {
+			hlog.Infof("[LaunchService] job %s is %s since %v, time it out", j.UUID, status, j.UpdatedAt)
+			reason := fmt.Sprintf("the job stayed %s for more than %v", status, config.GetLaunchPendingTimeout())
+			if err = NewJobService(ls.ctx).endJob(j, job.JobStatus_TimedOut, reason); err != nil {
+				if err = ignoreConflict(err); err != nil {
+					hlog.Errorf("[LaunchService] failed to time out job %s: %+v", j.UUID, err)
+				}
+			}
+		}
+	}
+}
+
+// retryQuarantines quarantines again the outputs of the finished jobs whose quarantine failed,
+// the outputs already quarantined are inspected again and the reviewed ones are left alone
+func (ls *LaunchService) retryQuarantines(before time.Time) {
+	jobs, err := db.QueryJobsToQuarantine(before)
+	if err != nil {
+		hlog.Errorf("[LaunchService] failed to query jobs to quarantine: %+v", err)
+		return
+	}
+	for _, j := range jobs {
+		// the update claims the retry, the other replicas see a recently updated job
+		if err = db.UpdateJob(j); err != nil {
+			if err = ignoreConflict(err); err != nil {
+				hlog.Errorf("[LaunchService] failed to claim job %s: %+v", j.UUID, err)
+			}
+			continue
+		}
+		hlog.Infof("[LaunchService] retry the quarantine of the outputs of job %s", j.UUID)
+		if err = NewJobService(ls.ctx).quarantineJobOutputs(j); err != nil {
+			hlog.Errorf("[LaunchService] failed to quarantine outputs of job %s: %+v", j.UUID, err)
+		}
+	}
+}
+
+// resumeAttempt creates the VM of the launching attempt unless it already exists, only one replica of the API
+// resumes an attempt
+func (ls *LaunchService) resumeAttempt(a *db.JobAttempt) error {
+	claimed, err := db.ClaimJobAttempt(a)
+	if err != nil || !claimed {
+		return err
+	}
+	j, err := db.QueryJobByUUID(a.JobUUID)
+	if err != nil {
+		return err
+	}
+	if jobstate.IsEnded(job.JobStatus(j.JobStatus)) || j.Attempts != a.Attempt {
+		return db.EndJobAttempt(a.JobUUID, a.Attempt, db.AttemptFailed, "the launch was abandoned")
+	}
+	hlog.Infof("[LaunchService] resume the launch of attempt %d of job %s", a.Attempt, j.UUID)
+	js := NewJobService(ls.ctx)
+	// the user token is not passed to the VM yet, see getTokenForStage2 of the cloud provider
+	if err = js.resumeLaunch(j, ""); err != nil {
+		js.failJob(j, err)
+		return err
+	}
+	if jobstate.CanTransition(job.JobStatus(j.JobStatus), job.JobStatus_VMRunning) {
+		return transitionJob(j, job.JobStatus_VMRunning)
+	}
+	return db.UpdateJob(j)
+}
+
+// settleWaitingJob moves a job that waits for its VM since too long according to its latest attempt:
+// it's running if the VM was created, and failed if the launch failed or never started
+func (ls *LaunchService) settleWaitingJob(j *db.Job) error {
+	reason := "the start of the job was interrupted before its VM was launched"
+	if j.Attempts > 0 {
+		a, err := db.QueryJobAttempt(j.UUID, j.Attempts)
+		if err != nil {
+			return err
+		}
+		switch a.Outcome {
+		case db.AttemptLaunching:
+			// the launch is resumed by resumeAttempt
+			return nil
+		case db.AttemptRunning:
+			hlog.Infof("[LaunchService] VM %s of job %s was created, the job is running", j.InstanceName, j.UUID)
+			j.Zone = a.Zone
+			return ignoreConflict(transitionJob(j, job.JobStatus_VMRunning))
+		default:
+			reason = fmt.Sprintf("the launch of the job failed: %s", a.Reason)
+		}
+	}
+	hlog.Infof("[LaunchService] job %s waited for its VM since %v, fail it", j.UUID, j.UpdatedAt)
+	j.FailureReason = reason
+	if err := transitionJob(j, job.JobStatus_VMFailed); err != nil {
+		return ignoreConflict(err)
+	}
+	NewJobService(ls.ctx).releaseJobResources(j)
+	return nil
+}
+
+// ignoreConflict drops the error of a job updated concurrently, the request that updated it settled the job
+func ignoreConflict(err error) error {
+	if errors.Is(err, errno.JobVersionConflictErr) {
+		return nil
+	}
+	return err
+}
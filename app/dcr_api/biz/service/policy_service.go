@@ -0,0 +1,101 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/policy"
+	attestationpolicy "github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/policy"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+)
+
+type PolicyService struct {
+	ctx context.Context
+}
+
+// NewPolicyService create policy service
+func NewPolicyService(ctx context.Context) *PolicyService {
+	return &PolicyService{ctx: ctx}
+}
+
+// CreatePolicy validates the document and stores it as a new version of the provider's policy
+func (ps *PolicyService) CreatePolicy(req *policy.CreatePolicyRequest) (int64, error) {
+	_, err := attestationpolicy.Parse([]byte(req.Document))
+	if err != nil {
+		return 0, err
+	}
+	p := db.AttestationPolicy{
+		Provider: req.Provider,
+		Document: req.Document,
+	}
+	err = db.CreatePolicy(&p)
+	if err != nil {
+		return 0, err
+	}
+	return p.Version, nil
+}
+
+func (ps *PolicyService) QueryPolicy(req *policy.QueryPolicyRequest) (*policy.Policy, error) {
+	p, err := db.QueryPolicy(req.Provider, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &policy.Policy{
+		Provider:  p.Provider,
+		Version:   p.Version,
+		Document:  p.Document,
+		CreatedAt: p.CreatedAt.Format(utils.Layout),
+	}, nil
+}
+
+// CompileJobPolicy compiles the policy version the job was submitted under and the access policies
+// of its datasets into an attribute condition
+func (ps *PolicyService) CompileJobPolicy(j *db.Job, facts attestationpolicy.JobFacts) (string, error) {
+	documents := []string{}
+	if j.PolicyProvider != "" {
+		p, err := db.QueryPolicy(j.PolicyProvider, j.PolicyVersion)
+		if err != nil {
+			return "", err
+		}
+		documents = append(documents, p.Document)
+	}
+	datasets, err := NewDatasetService(ps.ctx).GetJobDatasets(j)
+	if err != nil {
+		return "", err
+	}
+	for _, d := range datasets {
+		if d.AccessPolicy != "" {
+			documents = append(documents, d.AccessPolicy)
+		}
+	}
+	conditions := []string{}
+	for _, document := range documents {
+		p, err := attestationpolicy.Parse([]byte(document))
+		if err != nil {
+			return "", err
+		}
+		condition, err := p.Compile(facts)
+		if err != nil {
+			return "", err
+		}
+		if condition != "" {
+			conditions = append(conditions, condition)
+		}
+	}
+	return strings.Join(conditions, " && "), nil
+}
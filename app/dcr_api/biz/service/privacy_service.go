@@ -0,0 +1,302 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/privacy"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
+)
+
+// dpMechanisms are the DP mechanisms a job can declare, the value tells whether the mechanism is pure epsilon-DP.
+// Pure mechanisms spend no delta, the gaussian mechanism needs a positive delta
+var dpMechanisms = map[string]bool{
+	"laplace":     true,
+	"geometric":   true,
+	"exponential": true,
+	"gaussian":    false,
+}
+
+type PrivacyService struct {
+	ctx context.Context
+}
+
+// NewPrivacyService create privacy service
+func NewPrivacyService(ctx context.Context) *PrivacyService {
+	return &PrivacyService{ctx: ctx}
+}
+
+func convertLedgerEntryToModel(e *db.PrivacyLedgerEntry) *privacy.PrivacyLedgerEntry {
+	return &privacy.PrivacyLedgerEntry{
+		ID:        int64(e.ID),
+		JobUUID:   e.JobUUID,
+		Dataset:   e.DatasetName,
+		Analyst:   e.Analyst,
+		Mechanism: e.Mechanism,
+		Epsilon:   e.Epsilon,
+		Delta:     e.Delta,
+		Status:    e.Status,
+		CreatedAt: e.CreatedAt.Format(utils.Layout),
+		UpdatedAt: e.UpdatedAt.Format(utils.Layout),
+	}
+}
+
+// ResolveJobPrivacyBudgets checks the budget requests of a job, each one is dataset:mechanism:epsilon[:delta].
+// A dataset is governed by DP if it has a default budget or a budget for any analyst, every declared governed
+// dataset must be requested. Sequential composition adds up the requests of a dataset when the budget is reserved
+func (ps *PrivacyService) ResolveJobPrivacyBudgets(creator string, datasets []*db.JobDataset, requests []string) ([]*db.PrivacyLedgerEntry, error) {
+	names := []string{}
+	for _, d := range datasets {
+		names = append(names, d.DatasetName)
+	}
+	records := []*db.Dataset{}
+	budgets := []*db.PrivacyBudget{}
+	if len(names) > 0 {
+		var err error
+		records, err = db.QueryDatasetsByNames(names)
+		if err != nil {
+			return nil, err
+		}
+		budgets, err = db.QueryDatasetsPrivacyBudgets(names)
+		if err != nil {
+			return nil, err
+		}
+	}
+	declared := map[string]*db.Dataset{}
+	for _, d := range records {
+		declared[d.Name] = d
+	}
+	// the budget of the creator is its own one if set, the default one of the dataset otherwise
+	governed := map[string]bool{}
+	creatorBudgets := map[string]float64{}
+	for _, d := range records {
+		governed[d.Name] = d.DpEpsilonBudget > 0
+		creatorBudgets[d.Name] = d.DpEpsilonBudget
+	}
+	for _, b := range budgets {
+		governed[b.DatasetName] = true
+		if b.Analyst == creator {
+			creatorBudgets[b.DatasetName] = b.Epsilon
+		}
+	}
+	res := []*db.PrivacyLedgerEntry{}
+	requested := map[string]bool{}
+	for _, r := range requests {
+		e, err := parsePrivacyBudgetRequest(r)
+		if err != nil {
+			return nil, err
+		}
+		d, ok := declared[e.DatasetName]
+		if !ok {
+			return nil, fmt.Errorf("privacy budget is requested for dataset %s which the job doesn't declare", e.DatasetName)
+		}
+		if !governed[d.Name] {
+			return nil, fmt.Errorf("dataset %s has no privacy budget", d.Name)
+		}
+		if creatorBudgets[d.Name] <= 0 {
+			return nil, errno.PrivacyBudgetExceededErr.WithMessage(fmt.Sprintf(
+				"user %s has no privacy budget on dataset %s", creator, d.Name))
+		}
+		e.Analyst = creator
+		requested[d.Name] = true
+		res = append(res, e)
+	}
+	for _, d := range records {
+		if governed[d.Name] && !requested[d.Name] {
+			return nil, fmt.Errorf("dataset %s requires the job to declare its DP mechanisms and privacy budget", d.Name)
+		}
+	}
+	return res, nil
+}
+
+func parsePrivacyBudgetRequest(request string) (*db.PrivacyLedgerEntry, error) {
+	parts := strings.Split(request, ":")
+	if len(parts) != 3 && len(parts) != 4 {
+		return nil, fmt.Errorf("invalid privacy budget request %s, expected dataset:mechanism:epsilon[:delta]", request)
+	}
+	pure, ok := dpMechanisms[parts[1]]
+	if !ok {
+		return nil, fmt.Errorf("unsupported DP mechanism %s", parts[1])
+	}
+	epsilon, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil || epsilon <= 0 || math.IsInf(epsilon, 0) {
+		return nil, fmt.Errorf("invalid epsilon in privacy budget request %s", request)
+	}
+	delta := 0.0
+	if len(parts) == 4 {
+		delta, err = strconv.ParseFloat(parts[3], 64)
+		if err != nil || delta < 0 || delta >= 1 {
+			return nil, fmt.Errorf("invalid delta in privacy budget request %s", request)
+		}
+	}
+	if pure && delta != 0 {
+		return nil, fmt.Errorf("%s mechanism is pure epsilon-DP and spends no delta", parts[1])
+	}
+	if !pure && delta == 0 {
+		return nil, fmt.Errorf("%s mechanism requires a positive delta", parts[1])
+	}
+	return &db.PrivacyLedgerEntry{DatasetName: parts[0], Mechanism: parts[1], Epsilon: epsilon, Delta: delta}, nil
+}
+
+func formatPrivacyBudgetRequest(e *db.PrivacyLedgerEntry) string {
+	res := fmt.Sprintf("%s:%s:%g", e.DatasetName, e.Mechanism, e.Epsilon)
+	if e.Delta > 0 {
+		res = fmt.Sprintf("%s:%g", res, e.Delta)
+	}
+	return res
+}
+
+// ReserveJobPrivacyBudgets holds the requested budget until the job ends, it's rejected if a budget would be exceeded
+func (ps *PrivacyService) ReserveJobPrivacyBudgets(jobUUID string, entries []*db.PrivacyLedgerEntry) error {
+	for _, e := range entries {
+		e.JobUUID = jobUUID
+	}
+	return db.ReservePrivacyBudget(entries)
+}
+
+// SettleJobPrivacyBudgets debits the reserved budget of a finished job, the budget of any other ended job is released.
+// The outputs of a finished job can be released, by a reviewer if its attestation doesn't verify, so its budget
+// is debited whatever the attestation, a missing or invalid one is only logged
+func (ps *PrivacyService) SettleJobPrivacyBudgets(j *db.Job) {
+	status := db.PrivacyReleased
+	if j.JobStatus == int(job.JobStatus_VMFinished) {
+		status = db.PrivacyDebited
+		if _, _, err := NewAttestationService(ps.ctx).VerifyJobAttestation(j); err != nil {
+			hlog.Warnf("[PrivacyService] debiting privacy budget of job %s whose attestation doesn't verify: %+v", j.UUID, err)
+		}
+	}
+	if err := db.SettlePrivacyBudget(j.UUID, status); err != nil {
+		hlog.Errorf("[PrivacyService] failed to settle privacy budget of job %s: %+v", j.UUID, err)
+	}
+}
+
+func getProviderDataset(name string, provider string) (*db.Dataset, error) {
+	d, err := db.QueryDatasetByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if d.Provider != provider {
+		return nil, fmt.Errorf("dataset %s is not provided by %s", d.Name, provider)
+	}
+	return d, nil
+}
+
+// SetPrivacyBudget sets the budget of an analyst on the dataset, or the default budget of every analyst
+// if no analyst is given
+func (ps *PrivacyService) SetPrivacyBudget(req *privacy.SetPrivacyBudgetRequest) error {
+	d, err := getProviderDataset(req.Dataset, req.Provider)
+	if err != nil {
+		return err
+	}
+	if req.Analyst == "" {
+		d.DpEpsilonBudget = req.Epsilon
+		d.DpDeltaBudget = req.Delta
+		return db.UpdateDatasetPrivacyBudget(d)
+	}
+	return db.SetPrivacyBudget(&db.PrivacyBudget{
+		DatasetName: d.Name,
+		Analyst:     req.Analyst,
+		Epsilon:     req.Epsilon,
+		Delta:       req.Delta,
+	})
+}
+
+// QueryPrivacyBudgets reports the budget, spent and reserved epsilon and delta of each analyst on the dataset.
+// The first entry without an analyst is the default budget of the dataset
+func (ps *PrivacyService) QueryPrivacyBudgets(req *privacy.QueryPrivacyBudgetRequest) ([]*privacy.PrivacyBudget, error) {
+	d, err := getProviderDataset(req.Dataset, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	budgets, err := db.QueryPrivacyBudgets(d.Name)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := db.QueryPrivacyUsage(d.Name)
+	if err != nil {
+		return nil, err
+	}
+	analysts := map[string]*privacy.PrivacyBudget{}
+	getBudget := func(analyst string) *privacy.PrivacyBudget {
+		b, ok := analysts[analyst]
+		if !ok {
+			b = &privacy.PrivacyBudget{
+				Dataset:       d.Name,
+				Analyst:       analyst,
+				EpsilonBudget: d.DpEpsilonBudget,
+				DeltaBudget:   d.DpDeltaBudget,
+			}
+			analysts[analyst] = b
+		}
+		return b
+	}
+	for _, b := range budgets {
+		res := getBudget(b.Analyst)
+		res.EpsilonBudget = b.Epsilon
+		res.DeltaBudget = b.Delta
+	}
+	for _, u := range usage {
+		res := getBudget(u.Analyst)
+		switch u.Status {
+		case db.PrivacyDebited:
+			res.EpsilonSpent = u.Epsilon
+			res.DeltaSpent = u.Delta
+		case db.PrivacyReserved:
+			res.EpsilonReserved = u.Epsilon
+			res.DeltaReserved = u.Delta
+		}
+	}
+	res := []*privacy.PrivacyBudget{{
+		Dataset:       d.Name,
+		EpsilonBudget: d.DpEpsilonBudget,
+		DeltaBudget:   d.DpDeltaBudget,
+	}}
+	names := []string{}
+	for analyst := range analysts {
+		names = append(names, analyst)
+	}
+	sort.Strings(names)
+	for _, analyst := range names {
+		res = append(res, analysts[analyst])
+	}
+	return res, nil
+}
+
+func (ps *PrivacyService) QueryPrivacyLedger(req *privacy.QueryPrivacyLedgerRequest) ([]*privacy.PrivacyLedgerEntry, int64, error) {
+	d, err := getProviderDataset(req.Dataset, req.Provider)
+	if err != nil {
+		return nil, 0, err
+	}
+	entries, total, err := db.QueryPrivacyLedger(d.Name, req.Analyst, req.Page, req.PageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	res := []*privacy.PrivacyLedgerEntry{}
+	for _, e := range entries {
+		res = append(res, convertLedgerEntryToModel(e))
+	}
+	return res, total, nil
+}
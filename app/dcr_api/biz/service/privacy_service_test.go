@@ -0,0 +1,191 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+)
+
+var initTestDB sync.Once
+
+// testDB connects to the MySQL database of the MYSQL_* environment variables, the tests are skipped without one
+func testDB(t *testing.T) {
+	t.Helper()
+	if os.Getenv("MYSQL_HOST") == "" {
+		t.Skip("MYSQL_HOST is not set, the ledger tests need a MySQL database")
+	}
+	initTestDB.Do(db.Init)
+}
+
+// newTestDataset creates a dataset with a name of its own and the budgets of the analysts
+func newTestDataset(t *testing.T, epsilon float64, budgets map[string]float64) string {
+	t.Helper()
+	name := fmt.Sprintf("ledger-%d", time.Now().UnixNano())
+	if err := db.DB.Create(&db.Dataset{Name: name, Provider: "provider", DpEpsilonBudget: epsilon}).Error; err != nil {
+		t.Fatalf("failed to create dataset: %v", err)
+	}
+	for analyst, e := range budgets {
+		if err := db.SetPrivacyBudget(&db.PrivacyBudget{DatasetName: name, Analyst: analyst, Epsilon: e}); err != nil {
+			t.Fatalf("failed to set budget: %v", err)
+		}
+	}
+	return name
+}
+
+func TestParsePrivacyBudgetRequest(t *testing.T) {
+	tests := []struct {
+		request string
+		wantErr string
+	}{
+		{request: "census:laplace:0.5"},
+		{request: "census:gaussian:0.5:1e-6"},
+		{request: "census:laplace", wantErr: "expected dataset:mechanism:epsilon[:delta]"},
+		{request: "census:randomized:0.5", wantErr: "unsupported DP mechanism"},
+		{request: "census:laplace:0", wantErr: "invalid epsilon"},
+		{request: "census:laplace:-1", wantErr: "invalid epsilon"},
+		{request: "census:laplace:+Inf", wantErr: "invalid epsilon"},
+		{request: "census:gaussian:0.5:1", wantErr: "invalid delta"},
+		{request: "census:laplace:0.5:1e-6", wantErr: "spends no delta"},
+		{request: "census:gaussian:0.5", wantErr: "requires a positive delta"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.request, func(t *testing.T) {
+			e, err := parsePrivacyBudgetRequest(tt.request)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("failed to parse request: %v", err)
+				}
+				if formatPrivacyBudgetRequest(e) != tt.request {
+					t.Fatalf("request %s is formatted as %s", tt.request, formatPrivacyBudgetRequest(e))
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveJobPrivacyBudgets(t *testing.T) {
+	testDB(t)
+	ps := NewPrivacyService(context.Background())
+	ungoverned := newTestDataset(t, 0, nil)
+	governed := newTestDataset(t, 1, nil)
+	// datasets governed only through the budgets of some analysts
+	othersOnly := newTestDataset(t, 0, map[string]float64{"bob": 1})
+	creatorOnly := newTestDataset(t, 0, map[string]float64{"alice": 1})
+	revoked := newTestDataset(t, 1, map[string]float64{"alice": 0})
+	tests := []struct {
+		name     string
+		datasets []string
+		requests []string
+		wantErr  string
+		exceeded bool
+	}{
+		{name: "no DP", datasets: []string{ungoverned}},
+		{name: "request on a dataset without budget", datasets: []string{ungoverned},
+			requests: []string{ungoverned + ":laplace:0.1"}, wantErr: "has no privacy budget"},
+		{name: "request on an undeclared dataset", datasets: []string{ungoverned},
+			requests: []string{governed + ":laplace:0.1"}, wantErr: "which the job doesn't declare"},
+		{name: "default budget", datasets: []string{governed}, requests: []string{governed + ":laplace:0.1"}},
+		{name: "missing request on a default budget", datasets: []string{governed},
+			wantErr: "requires the job to declare"},
+		{name: "missing request on an analyst budget", datasets: []string{othersOnly},
+			wantErr: "requires the job to declare"},
+		{name: "analyst budget of others", datasets: []string{othersOnly},
+			requests: []string{othersOnly + ":laplace:0.1"}, exceeded: true},
+		{name: "analyst budget of the creator", datasets: []string{creatorOnly},
+			requests: []string{creatorOnly + ":laplace:0.1"}},
+		{name: "revoked budget of the creator", datasets: []string{revoked},
+			requests: []string{revoked + ":laplace:0.1"}, exceeded: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			datasets := []*db.JobDataset{}
+			for _, name := range tt.datasets {
+				datasets = append(datasets, &db.JobDataset{DatasetName: name})
+			}
+			entries, err := ps.ResolveJobPrivacyBudgets("alice", datasets, tt.requests)
+			switch {
+			case tt.exceeded:
+				if !errors.Is(err, errno.PrivacyBudgetExceededErr) {
+					t.Fatalf("got error %v, want the budget to be exceeded", err)
+				}
+			case tt.wantErr != "":
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+			case err != nil:
+				t.Fatalf("failed to resolve budgets: %v", err)
+			case len(entries) != len(tt.requests):
+				t.Fatalf("got %d entries for %d requests", len(entries), len(tt.requests))
+			}
+			for _, e := range entries {
+				if e.Analyst != "alice" {
+					t.Fatalf("entry %+v isn't charged to the creator", e)
+				}
+			}
+		})
+	}
+}
+
+func TestSettleJobPrivacyBudgets(t *testing.T) {
+	testDB(t)
+	ps := NewPrivacyService(context.Background())
+	dataset := newTestDataset(t, 1, nil)
+	settle := func(uuid string, status job.JobStatus) {
+		t.Helper()
+		entries, err := ps.ResolveJobPrivacyBudgets("alice", []*db.JobDataset{{DatasetName: dataset}},
+			[]string{dataset + ":laplace:0.6"})
+		if err != nil {
+			t.Fatalf("failed to resolve budgets: %v", err)
+		}
+		if err = ps.ReserveJobPrivacyBudgets(uuid, entries); err != nil {
+			t.Fatalf("failed to reserve budget: %v", err)
+		}
+		ps.SettleJobPrivacyBudgets(&db.Job{UUID: uuid, JobStatus: int(status)})
+	}
+	// a failed job gives its budget back
+	settle(dataset+"-failed", job.JobStatus_VMFailed)
+	// a finished job is debited even without an attestation, its outputs may still be released
+	settle(dataset+"-finished", job.JobStatus_VMFinished)
+	entries, err := db.QueryJobPrivacyLedger([]string{dataset + "-failed", dataset + "-finished"})
+	if err != nil {
+		t.Fatalf("failed to query ledger: %v", err)
+	}
+	if s := entries[dataset+"-failed"][0].Status; s != db.PrivacyReleased {
+		t.Fatalf("budget of the failed job is %s", s)
+	}
+	if s := entries[dataset+"-finished"][0].Status; s != db.PrivacyDebited {
+		t.Fatalf("budget of the finished job is %s", s)
+	}
+	// only the debited budget is spent
+	err = ps.ReserveJobPrivacyBudgets(dataset+"-over", []*db.PrivacyLedgerEntry{{DatasetName: dataset, Analyst: "alice", Mechanism: "laplace", Epsilon: 0.6}})
+	if !errors.Is(err, errno.PrivacyBudgetExceededErr) {
+		t.Fatalf("got error %v, want the budget to be exceeded", err)
+	}
+}
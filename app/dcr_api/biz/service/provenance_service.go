@@ -0,0 +1,148 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/cloud"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/provenance"
+)
+
+type ProvenanceService struct {
+	ctx context.Context
+}
+
+// NewProvenanceService create provenance service
+func NewProvenanceService(ctx context.Context) *ProvenanceService {
+	return &ProvenanceService{ctx: ctx}
+}
+
+// PublishProvenance generates the SBOM and the SLSA provenance of the built image from the recorded build inputs,
+// stores them in cloud storage and attaches them to the image in the registry
+func (ps *ProvenanceService) PublishProvenance(j *db.Job) error {
+	provider := cloud.GetCloudProvider(ps.ctx)
+	inputs, err := readBuildInputs(provider, j)
+	if err != nil {
+		return err
+	}
+	image := config.GetJobDockerImageFull(j.Creator, j.UUID)
+	now := time.Now()
+	sbom, err := provenance.NewSbom(image, j.DockerImageDigest, inputs, now)
+	if err != nil {
+		return err
+	}
+	statement, err := provenance.NewProvenance(image, j.DockerImageDigest, inputs, now)
+	if err != nil {
+		return err
+	}
+	if err = provider.UploadFile(bytes.NewReader(sbom), config.GetJobSbomPath(j.Creator, j.UUID), false); err != nil {
+		return err
+	}
+	if err = provider.UploadFile(bytes.NewReader(statement), config.GetJobProvenancePath(j.Creator, j.UUID), false); err != nil {
+		return err
+	}
+	if err = provider.AttachArtifact(image, j.DockerImageDigest, provenance.SbomMimeType, sbom); err != nil {
+		return err
+	}
+	if err = provider.AttachArtifact(image, j.DockerImageDigest, provenance.ProvenanceMimeType, statement); err != nil {
+		return err
+	}
+	hlog.Infof("[ProvenanceService] published sbom and provenance of %s@%s", image, j.DockerImageDigest)
+	return nil
+}
+
+// GetJobProvenance returns the SBOM and the provenance of the image of the job to its creator
+// and to the providers of the datasets it declared
+func (ps *ProvenanceService) GetJobProvenance(req *job.QueryJobProvenanceRequest) (string, string, error) {
+	j, err := db.QueryJobById(req.ID)
+	if err != nil {
+		return "", "", err
+	}
+	if j.Creator != req.Creator {
+		provides, err := isJobDatasetProvider(j, req.Creator)
+		if err != nil {
+			return "", "", err
+		}
+		if !provides {
+			return "", "", fmt.Errorf("%s is neither the creator of job %d nor a provider of its datasets", req.Creator, req.ID)
+		}
+	}
+	provider := cloud.GetCloudProvider(ps.ctx)
+	sbom, err := readCloudFile(provider, config.GetJobSbomPath(j.Creator, j.UUID))
+	if err != nil {
+		return "", "", err
+	}
+	statement, err := readCloudFile(provider, config.GetJobProvenancePath(j.Creator, j.UUID))
+	if err != nil {
+		return "", "", err
+	}
+	return string(sbom), string(statement), nil
+}
+
+// isJobDatasetProvider reports whether the user provides one of the datasets the job declared
+func isJobDatasetProvider(j *db.Job, user string) (bool, error) {
+	records, err := db.QueryJobDatasets([]string{j.UUID})
+	if err != nil {
+		return false, err
+	}
+	var names []string
+	for _, r := range records[j.UUID] {
+		names = append(names, r.DatasetName)
+	}
+	if len(names) == 0 {
+		return false, nil
+	}
+	datasets, err := db.QueryDatasetsByNames(names)
+	if err != nil {
+		return false, err
+	}
+	for _, d := range datasets {
+		if d.Provider == user {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// readBuildInputs reads the inputs recorded when the image of the job was built
+func readBuildInputs(provider cloud.CloudProvider, j *db.Job) (*provenance.BuildInputs, error) {
+	inputsBytes, err := readCloudFile(provider, config.GetBuildInputsPath(j.Creator, j.UUID))
+	if err != nil {
+		return nil, err
+	}
+	inputs := &provenance.BuildInputs{}
+	if err = json.Unmarshal(inputsBytes, inputs); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal build inputs")
+	}
+	return inputs, nil
+}
+
+func readCloudFile(provider cloud.CloudProvider, remotePath string) ([]byte, error) {
+	size, err := provider.GetFileSize(remotePath)
+	if err != nil {
+		return nil, err
+	}
+	return provider.GetFilebyChunk(remotePath, 0, size)
+}
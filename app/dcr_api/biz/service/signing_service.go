@@ -0,0 +1,85 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal/db"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/cloud"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/signature"
+)
+
+type SigningService struct {
+	ctx context.Context
+}
+
+// NewSigningService create signing service
+func NewSigningService(ctx context.Context) *SigningService {
+	return &SigningService{ctx: ctx}
+}
+
+// SignImage signs the job image with the platform key in KMS and pushes the cosign signature to its repository
+func (ss *SigningService) SignImage(j *db.Job) error {
+	provider := cloud.GetCloudProvider(ss.ctx)
+	keyVersion := config.GetImageSigningKeyVersion()
+	publicKey, err := provider.GetPublicKey(keyVersion)
+	if err != nil {
+		return err
+	}
+	payload, err := signature.NewPayload(config.GetJobDockerImageRepository(j.Creator, j.UUID), j.DockerImageDigest, publicKey)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(payload)
+	sig, err := provider.SignWithKMS(keyVersion, digest[:])
+	if err != nil {
+		return err
+	}
+	err = provider.PushImageSignature(config.GetJobDockerImageFull(j.Creator, j.UUID), j.DockerImageDigest, payload, base64.StdEncoding.EncodeToString(sig))
+	if err != nil {
+		return err
+	}
+	hlog.Infof("[SigningService] signed image of job %s with digest %s", j.UUID, j.DockerImageDigest)
+	return nil
+}
+
+// VerifyImage checks the registry holds a valid platform signature of the job image and returns the key id
+// confidential space reports for it
+func (ss *SigningService) VerifyImage(j *db.Job) (string, error) {
+	provider := cloud.GetCloudProvider(ss.ctx)
+	publicKey, err := provider.GetPublicKey(config.GetImageSigningKeyVersion())
+	if err != nil {
+		return "", err
+	}
+	signatures, err := provider.GetImageSignatures(config.GetJobDockerImageFull(j.Creator, j.UUID), j.DockerImageDigest)
+	if err != nil {
+		return "", err
+	}
+	for _, sig := range signatures {
+		err = signature.Verify(publicKey, j.DockerImageDigest, sig.Payload, sig.Signature)
+		if err != nil {
+			hlog.Warnf("[SigningService] invalid signature of job %s: %v", j.UUID, err)
+			continue
+		}
+		return signature.KeyID(publicKey)
+	}
+	return "", fmt.Errorf("no valid signature for image of job %s", j.UUID)
+}
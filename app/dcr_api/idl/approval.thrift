@@ -0,0 +1,85 @@
+namespace go approval
+
+struct Approval {
+    1: string job_uuid
+    2: string provider
+    3: string decision
+    4: string comment
+    5: string created_at
+    6: string updated_at
+}
+
+struct AuditLog {
+    1: i64 id
+    2: string job_uuid
+    3: string actor
+    4: string action
+    5: string detail
+    6: string created_at
+}
+
+struct QueryApprovalRequest {
+    1: i64 page (api.body="page", api.query="page",api.vd="$>0")
+    2: i64 page_size (api.body="page_size", api.query="page_size", api.vd="$ > 0 || $ <= 100")
+    3: string provider (api.body="provider", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryApprovalResponse {
+    1: i32 code
+    2: string msg
+    3: list<Approval> approvals
+    4: i64 total
+}
+
+struct ReviewJobRequest {
+    1: string uuid (api.body="uuid", api.query="uuid", api.vd="len($) > 0")
+    2: string provider (api.body="provider", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct ReviewJobResponse {
+    1: i32 code
+    2: string msg
+    3: string creator
+    4: string jupyter_file_name
+    5: string notebook
+    6: string notebook_sha256
+    7: string docker_image
+    8: string docker_image_digest
+    9: string sbom
+    10: list<string> datasets
+    11: list<Approval> approvals
+}
+
+struct DecideJobRequest {
+    1: string uuid (api.body="uuid", api.query="uuid", api.vd="len($) > 0")
+    2: string provider (api.body="provider", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    3: string decision (api.body="decision", api.vd="in($, 'approved', 'rejected')")
+    4: string comment (api.body="comment", api.vd="len($) < 1024")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct DecideJobResponse {
+    1: i32 code
+    2: string msg
+}
+
+struct QueryAuditLogRequest {
+    1: string uuid (api.body="uuid", api.query="uuid", api.vd="len($) > 0")
+    2: string actor (api.body="actor", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryAuditLogResponse {
+    1: i32 code
+    2: string msg
+    3: list<AuditLog> logs
+}
+
+service ApprovalHandler {
+    QueryApprovalResponse QueryApproval(1:QueryApprovalRequest req)(api.post="/v1/approval/query/")
+    ReviewJobResponse ReviewJob(1:ReviewJobRequest req)(api.post="/v1/approval/review/")
+    DecideJobResponse DecideJob(1:DecideJobRequest req)(api.post="/v1/approval/decide/")
+    QueryAuditLogResponse QueryAuditLog(1:QueryAuditLogRequest req)(api.post="/v1/approval/audit/")
+}
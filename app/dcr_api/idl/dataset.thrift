@@ -0,0 +1,121 @@
+namespace go dataset
+
+struct Dataset {
+    1: i64 id
+    2: string name
+    3: string provider
+    4: string description
+    5: string location
+    6: string key_name
+    7: string access_policy
+    8: string created_at
+    9: string updated_at
+    10: bool require_approval
+    11: double dp_epsilon_budget
+    12: double dp_delta_budget
+}
+
+struct RegisterDatasetRequest {
+    1: string name (api.body="name", api.vd="regexp('^[a-z][a-z0-9-]{0,30}$')")
+    2: string provider (api.body="provider", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    3: string description (api.body="description", api.vd="len($) < 1024")
+    4: string access_policy (api.body="access_policy", api.vd="len($) < 65536")
+    5: string encrypter (api.body="encrypter", api.vd="len($) == 0 || regexp('^(user|group|serviceAccount):[^@\\s]+@[^@\\s]+$')")
+    6: bool require_approval (api.body="require_approval")
+    7: double dp_epsilon_budget (api.body="dp_epsilon_budget", api.vd="$ >= 0")
+    8: double dp_delta_budget (api.body="dp_delta_budget", api.vd="$ >= 0 && $ < 1")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct RegisterDatasetResponse {
+    1: i32 code
+    2: string msg
+    3: Dataset dataset
+}
+
+struct QueryDatasetRequest {
+    1: i64 page (api.body="page", api.query="page",api.vd="$>0")
+    2: i64 page_size (api.body="page_size", api.query="page_size", api.vd="$ > 0 || $ <= 100")
+    3: string provider (api.body="provider", api.vd="len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryDatasetResponse {
+    1: i32 code
+    2: string msg
+    3: list<Dataset> datasets
+    4: i64 total
+}
+
+struct DescribeDatasetRequest {
+    1: string name (api.body="name", api.query="name", api.vd="regexp('^[a-z][a-z0-9-]{0,30}$')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct DescribeDatasetResponse {
+    1: i32 code
+    2: string msg
+    3: Dataset dataset
+}
+
+struct DatasetPreview {
+    1: string dataset
+    2: i64 version
+    3: string kind
+    4: string description
+    5: string filename
+    6: i64 size
+    7: string sha256
+    8: string created_at
+}
+
+struct UploadDatasetPreviewRequest {
+    1: string name (api.body="name", api.vd="regexp('^[a-z][a-z0-9-]{0,30}$')")
+    2: string provider (api.body="provider", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    3: string kind (api.body="kind", api.vd="in($, 'synthetic', 'dp_synthetic', 'sampled', 'random')")
+    4: string description (api.body="description", api.vd="len($) < 1024")
+    5: string filename (api.body="filename", api.vd="regexp('^[A-Za-z0-9][A-Za-z0-9._-]{0,127}$')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct UploadDatasetPreviewResponse {
+    1: i32 code
+    2: string msg
+    3: DatasetPreview preview
+}
+
+struct QueryDatasetPreviewRequest {
+    1: string name (api.body="name", api.query="name", api.vd="regexp('^[a-z][a-z0-9-]{0,30}$')")
+    2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryDatasetPreviewResponse {
+    1: i32 code
+    2: string msg
+    3: list<DatasetPreview> previews
+}
+
+struct DownloadDatasetPreviewRequest {
+    1: string name (api.body="name", api.query="name", api.vd="regexp('^[a-z][a-z0-9-]{0,30}$')")
+    2: i64 version (api.body="version", api.query="version", api.vd="$>0")
+    3: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    4: i64 offset (api.body="offset", api.query="offset")
+    5: i64 chunk (api.body="chunk", api.query="chunk", api.vd="$>0 && $ < 5242880")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct DownloadDatasetPreviewResponse {
+    1: i32 code
+    2: string msg
+    3: string content
+}
+
+service DatasetHandler {
+    RegisterDatasetResponse RegisterDataset(1:RegisterDatasetRequest req)(api.post="/v1/dataset/register/")
+    QueryDatasetResponse QueryDataset(1:QueryDatasetRequest req)(api.post="/v1/dataset/query/")
+    DescribeDatasetResponse DescribeDataset(1:DescribeDatasetRequest req)(api.post="/v1/dataset/describe/")
+    UploadDatasetPreviewResponse UploadDatasetPreview(1:UploadDatasetPreviewRequest req)(api.post="/v1/dataset/preview/upload/")
+    QueryDatasetPreviewResponse QueryDatasetPreview(1:QueryDatasetPreviewRequest req)(api.post="/v1/dataset/preview/query/")
+    DownloadDatasetPreviewResponse DownloadDatasetPreview(1:DownloadDatasetPreviewRequest req)(api.post="/v1/dataset/preview/download/")
+}
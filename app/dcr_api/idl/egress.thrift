@@ -0,0 +1,80 @@
+namespace go egress
+
+struct JobOutput {
+    1: string job_uuid
+    2: string creator
+    3: string filename
+    4: i64 size
+    5: string sha256
+    6: string status
+    7: string report
+    8: string reviewer
+    9: string comment
+    10: string created_at
+    11: string updated_at
+    12: string attested_sha256
+}
+
+struct QueryJobOutputsRequest {
+    1: i64 page (api.body="page", api.query="page",api.vd="$>0")
+    2: i64 page_size (api.body="page_size", api.query="page_size", api.vd="$ > 0 || $ <= 100")
+    3: string reviewer (api.body="reviewer", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryJobOutputsResponse {
+    1: i32 code
+    2: string msg
+    3: list<JobOutput> outputs
+    4: i64 total
+}
+
+struct DownloadQuarantinedOutputRequest {
+    1: string uuid (api.body="uuid", api.query="uuid", api.vd="len($) > 0")
+    2: string filename (api.body="filename", api.query="filename", api.vd="len($) > 0 && len($) < 128 && !regexp('.*\\.\\..*')")
+    3: string reviewer (api.body="reviewer", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    4: i64 offset (api.body="offset", api.query="offset")
+    5: i64 chunk (api.body="chunk", api.query="chunk", api.vd="$>0 && $ < 5242880")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct DownloadQuarantinedOutputResponse {
+    1: i32 code
+    2: string msg
+    3: string content
+}
+
+struct DecideJobOutputRequest {
+    1: string uuid (api.body="uuid", api.query="uuid", api.vd="len($) > 0")
+    2: string filename (api.body="filename", api.query="filename", api.vd="len($) > 0 && len($) < 128 && !regexp('.*\\.\\..*')")
+    3: string reviewer (api.body="reviewer", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    4: string decision (api.body="decision", api.vd="in($, 'release', 'reject')")
+    5: string comment (api.body="comment", api.vd="len($) < 1024")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct DecideJobOutputResponse {
+    1: i32 code
+    2: string msg
+}
+
+struct RedactJobOutputRequest {
+    1: string uuid (api.body="uuid", api.query="uuid", api.vd="len($) > 0")
+    2: string filename (api.body="filename", api.query="filename", api.vd="len($) > 0 && len($) < 128 && !regexp('.*\\.\\..*')")
+    3: string reviewer (api.body="reviewer", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    4: string comment (api.body="comment", api.vd="len($) < 1024")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct RedactJobOutputResponse {
+    1: i32 code
+    2: string msg
+    3: JobOutput output
+}
+
+service EgressHandler {
+    QueryJobOutputsResponse QueryJobOutputs(1:QueryJobOutputsRequest req)(api.post="/v1/egress/query/")
+    DownloadQuarantinedOutputResponse DownloadQuarantinedOutput(1:DownloadQuarantinedOutputRequest req)(api.post="/v1/egress/download/")
+    DecideJobOutputResponse DecideJobOutput(1:DecideJobOutputRequest req)(api.post="/v1/egress/decide/")
+    RedactJobOutputResponse RedactJobOutput(1:RedactJobOutputRequest req)(api.post="/v1/egress/redact/")
+}
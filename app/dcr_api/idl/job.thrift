@@ -9,6 +9,12 @@ enum JobStatus {
     VMKilled = 6
     VMFailed = 7
     VMOther = 8
+    PendingApproval = 9
+    ApprovalRejected = 10
+    VMPreempted = 11
+    Queued = 12
+    Cancelled = 13
+    TimedOut = 14
 }
 
 struct Job {
@@ -19,11 +25,29 @@ struct Job {
     5: string jupyter_file_name
     6: string created_at
     7: string updated_at
+    8: string failure_reason
+    9: string policy_provider
+    10: i64 policy_version
+    11: list<string> datasets
+    12: list<string> privacy_budgets
+    13: string tee_type
+    14: string profile
+    15: bool spot
+    16: i32 attempts
+    17: string zone
+    18: string network_mode
 }
 
 struct SubmitJobRequest{
     1: string jupyter_file_name (api.body="filename", api.vd="len($) > 0 && len($) < 128 && regexp('^.*\\.ipynb$') && !regexp('.*\\.\\..*')")
     2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    3: string policy_provider (api.body="provider", api.vd="len($) < 32 && !regexp('.*\\.\\..*')")
+    4: list<string> datasets (api.body="datasets")
+    5: list<string> privacy_budgets (api.body="privacy_budgets")
+    6: string tee_type (api.body="tee_type")
+    7: string profile (api.body="profile")
+    8: bool spot (api.body="spot")
+    9: string network_mode (api.body="network_mode")
     255: required string access_token     (api.header="Authorization")
 }
 
@@ -58,6 +82,17 @@ struct DeleteJobResponse {
     2: string msg
 }
 
+struct CancelJobRequest {
+    1: string uuid (api.body="uuid", api.query="uuid")
+    2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct CancelJobResponse {
+    1: i32 code
+    2: string msg
+}
+
 struct UpdateJobStatusRequest {
     1: string uuid (api.body="uuid", api.query="uuid")
     2: JobStatus status (api.body="status", api.query="status")
@@ -65,6 +100,7 @@ struct UpdateJobStatusRequest {
     4: string docker_image_digest (api.body="digest", api.query="digest")
     5: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
     6: string attestation_token (api.body="token", api.query="token")
+    7: string failure_reason (api.body="reason", api.query="reason")
     255: required string access_token     (api.header="Authorization")
 }
 
@@ -76,6 +112,7 @@ struct UpdateJobStatusResponse {
 struct QueryJobOutputRequest {
     1: i64 id (api.body="id", api.query="id")
     2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    3: string filename (api.body="filename", api.query="filename", api.vd="len($) < 128 && !regexp('.*\\.\\..*')")
     255: required string access_token     (api.header="Authorization")
 }
 
@@ -91,6 +128,7 @@ struct DownloadJobOutputRequest {
     2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
     3: i64 offset (api.body="offset", api.query="offset")
     4: i64 chunk (api.body="chunk", api.query="chunk", api.vd="$>0 && $ < 5242880")
+    5: string filename (api.body="filename", api.query="filename", api.vd="len($) < 128 && !regexp('.*\\.\\..*')")
     255: required string access_token     (api.header="Authorization")
 }
 
@@ -100,6 +138,44 @@ struct DownloadJobOutputResponse {
     3: string content
 }
 
+struct JobArtifact {
+    1: string filename
+    2: i64 size
+    3: string sha256
+    4: string attested_sha256
+    5: string status
+}
+
+struct ListJobOutputsRequest {
+    1: i64 id (api.body="id", api.query="id", api.vd="$>0")
+    2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct ListJobOutputsResponse {
+    1: i32 code
+    2: string msg
+    3: list<JobArtifact> outputs
+    4: string manifest_root
+}
+
+struct VerifyJobOutputRequest {
+    1: i64 id (api.body="id", api.query="id", api.vd="$>0")
+    2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    3: string filename (api.body="filename", api.query="filename", api.vd="len($) > 0 && len($) < 128 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct VerifyJobOutputResponse {
+    1: i32 code
+    2: string msg
+    3: bool verified
+    4: string sha256
+    5: string attested_sha256
+    6: string manifest_root
+    7: string reason
+}
+
 struct QueryJobAttestationRequest {
     1: i64 id (api.body="id", api.query="id", api.vd="$>0")
     2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
@@ -111,12 +187,84 @@ struct QueryJobAttestationResponse {
     3: string token
 }
 
+struct VerifyJobAttestationRequest {
+    1: i64 id (api.body="id", api.query="id", api.vd="$>0")
+    2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct VerifyJobAttestationResponse {
+    1: i32 code
+    2: string msg
+    3: bool verified
+    4: string reason
+    5: string nonce
+    6: string commitment
+}
+
+struct QueryJobProvenanceRequest {
+    1: i64 id (api.body="id", api.query="id", api.vd="$>0")
+    2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryJobProvenanceResponse {
+    1: i32 code
+    2: string msg
+    3: string sbom
+    4: string provenance
+}
+
+struct JobAttempt {
+    1: i32 attempt
+    2: string instance_name
+    3: bool spot
+    4: string outcome
+    5: string reason
+    6: string started_at
+    7: string ended_at
+    8: string zone
+}
+
+struct QueryJobAttemptsRequest {
+    1: i64 id (api.body="id", api.query="id", api.vd="$>0")
+    2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryJobAttemptsResponse {
+    1: i32 code
+    2: string msg
+    3: list<JobAttempt> attempts
+}
+
+struct QueryJobStateRequest {
+    1: string uuid (api.body="uuid", api.query="uuid", api.vd="len($) > 0 && len($) < 64")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryJobStateResponse {
+    1: i32 code
+    2: string msg
+    3: string creator
+    4: JobStatus job_status
+    5: string instance_name
+    6: bool ended
+}
+
 service JobHandler {
     SubmitJobResponse SubmitJob(1:SubmitJobRequest req)(api.post="/v1/job/submit/")
     QueryJobResponse QueryJob(1:QueryJobRequest req)(api.post="/v1/job/query/")
     DeleteJobResponse DeleteJob(1:DeleteJobRequest req)(api.post="/v1/job/delete/")
+    CancelJobResponse CancelJob(1:CancelJobRequest req)(api.post="/v1/job/cancel/")
     UpdateJobStatusResponse UpdateJobStatus(1:UpdateJobStatusRequest req)(api.post="/v1/job/update/")
     QueryJobOutputResponse QueryJobOutputAttr(1:QueryJobOutputRequest req) (api.post="/v1/job/output/attrs/")
     DownloadJobOutputResponse DownloadJobOutput(1:DownloadJobOutputRequest req) (api.post="/v1/job/output/download/")
+    ListJobOutputsResponse ListJobOutputs(1:ListJobOutputsRequest req) (api.post="/v1/job/output/list/")
+    VerifyJobOutputResponse VerifyJobOutput(1:VerifyJobOutputRequest req) (api.post="/v1/job/output/verify/")
     QueryJobAttestationResponse QueryJobAttestationReport(1:QueryJobAttestationRequest req)  (api.post="/v1/job/attestation/")
+    VerifyJobAttestationResponse VerifyJobAttestation(1:VerifyJobAttestationRequest req)  (api.post="/v1/job/attestation/verify/")
+    QueryJobProvenanceResponse QueryJobProvenance(1:QueryJobProvenanceRequest req)  (api.post="/v1/job/provenance/")
+    QueryJobAttemptsResponse QueryJobAttempts(1:QueryJobAttemptsRequest req)  (api.post="/v1/job/attempts/")
+    QueryJobStateResponse QueryJobState(1:QueryJobStateRequest req)  (api.post="/v1/job/state/")
 }
\ No newline at end of file
@@ -0,0 +1,37 @@
+namespace go policy
+
+struct Policy {
+    1: string provider
+    2: i64 version
+    3: string document
+    4: string created_at
+}
+
+struct CreatePolicyRequest {
+    1: string provider (api.body="provider", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    2: string document (api.body="document", api.vd="len($) > 0 && len($) < 65536")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct CreatePolicyResponse {
+    1: i32 code
+    2: string msg
+    3: i64 version
+}
+
+struct QueryPolicyRequest {
+    1: string provider (api.body="provider", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    2: i64 version (api.body="version", api.query="version", api.vd="$ >= 0")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryPolicyResponse {
+    1: i32 code
+    2: string msg
+    3: Policy policy
+}
+
+service PolicyHandler {
+    CreatePolicyResponse CreatePolicy(1:CreatePolicyRequest req)(api.post="/v1/policy/create/")
+    QueryPolicyResponse QueryPolicy(1:QueryPolicyRequest req)(api.post="/v1/policy/query/")
+}
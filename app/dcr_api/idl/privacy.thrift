@@ -0,0 +1,73 @@
+namespace go privacy
+
+struct PrivacyBudget {
+    1: string dataset
+    2: string analyst
+    3: double epsilon_budget
+    4: double delta_budget
+    5: double epsilon_spent
+    6: double delta_spent
+    7: double epsilon_reserved
+    8: double delta_reserved
+}
+
+struct PrivacyLedgerEntry {
+    1: i64 id
+    2: string job_uuid
+    3: string dataset
+    4: string analyst
+    5: string mechanism
+    6: double epsilon
+    7: double delta
+    8: string status
+    9: string created_at
+    10: string updated_at
+}
+
+struct SetPrivacyBudgetRequest {
+    1: string dataset (api.body="dataset", api.vd="regexp('^[a-z][a-z0-9-]{0,30}$')")
+    2: string provider (api.body="provider", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    3: string analyst (api.body="analyst", api.vd="len($) < 32 && !regexp('.*\\.\\..*')")
+    4: double epsilon (api.body="epsilon", api.vd="$ >= 0")
+    5: double delta (api.body="delta", api.vd="$ >= 0 && $ < 1")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct SetPrivacyBudgetResponse {
+    1: i32 code
+    2: string msg
+}
+
+struct QueryPrivacyBudgetRequest {
+    1: string dataset (api.body="dataset", api.vd="regexp('^[a-z][a-z0-9-]{0,30}$')")
+    2: string provider (api.body="provider", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryPrivacyBudgetResponse {
+    1: i32 code
+    2: string msg
+    3: list<PrivacyBudget> budgets
+}
+
+struct QueryPrivacyLedgerRequest {
+    1: i64 page (api.body="page", api.query="page",api.vd="$>0")
+    2: i64 page_size (api.body="page_size", api.query="page_size", api.vd="$ > 0 || $ <= 100")
+    3: string dataset (api.body="dataset", api.vd="regexp('^[a-z][a-z0-9-]{0,30}$')")
+    4: string provider (api.body="provider", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    5: string analyst (api.body="analyst", api.vd="len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryPrivacyLedgerResponse {
+    1: i32 code
+    2: string msg
+    3: list<PrivacyLedgerEntry> entries
+    4: i64 total
+}
+
+service PrivacyHandler {
+    SetPrivacyBudgetResponse SetPrivacyBudget(1:SetPrivacyBudgetRequest req)(api.post="/v1/privacy/budget/set/")
+    QueryPrivacyBudgetResponse QueryPrivacyBudget(1:QueryPrivacyBudgetRequest req)(api.post="/v1/privacy/budget/query/")
+    QueryPrivacyLedgerResponse QueryPrivacyLedger(1:QueryPrivacyLedgerRequest req)(api.post="/v1/privacy/ledger/")
+}
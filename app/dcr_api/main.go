@@ -3,10 +3,13 @@
 package main
 
 import (
+	"context"
+
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/dal"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/service"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
 )
 
@@ -17,6 +20,7 @@ func Init() {
 		panic(err)
 	}
 	dal.Init()
+	service.StartLaunchReconciler(context.Background())
 }
 
 func main() {
@@ -26,6 +26,7 @@ var (
 
 const (
 	UpdatePath = "/v1/job/update/"
+	StatePath  = "/v1/job/state/"
 )
 
 func InitHTTPClient() {
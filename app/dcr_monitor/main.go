@@ -19,9 +19,9 @@ func main() {
 	client.InitK8sClient()
 	client.InitHTTPClient()
 	ctx := context.Background()
-	err = monitor.CheckKanikoJobs(ctx, client.K8sClientSet)
+	err = monitor.CheckBuildJobs(ctx, client.K8sClientSet)
 	if err != nil {
-		hlog.Errorf("[CronJob]failed to check kaniko jobs %+v", err)
+		hlog.Errorf("[CronJob]failed to check image build jobs %+v", err)
 	}
 	err = monitor.CheckTeeInstance(ctx)
 	if err != nil {
@@ -15,14 +15,10 @@
 package monitor
 
 import (
-	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"os"
-	"regexp"
-	"strings"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/protocol"
@@ -37,72 +33,75 @@ import (
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_monitor/client"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/cloud"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/errno"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/utils"
 )
 
-func CheckKanikoJobs(ctx context.Context, clientSet *kubernetes.Clientset) error {
-	hlog.Info("start to monitor kaniko build jobs")
-	jobs, err := clientSet.BatchV1().Jobs(client.RunningNameSpace).List(context.TODO(), metav1.ListOptions{})
+// CheckBuildJobs reports the result of image build jobs created by any builder
+func CheckBuildJobs(ctx context.Context, clientSet *kubernetes.Clientset) error {
+	hlog.Info("start to monitor image build jobs")
+	jobs, err := clientSet.BatchV1().Jobs(client.RunningNameSpace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: utils.BuilderLabel,
+	})
 	if err != nil {
-		hlog.Errorf("[KanikoJobMonitor]failed to get job: %v", err)
+		hlog.Errorf("[BuildJobMonitor]failed to get job: %v", err)
 		return errors.Wrap(err, "failed to list jobs")
 	}
 
 	for _, j := range jobs.Items {
-		if !strings.Contains(j.Name, "kaniko-") {
-			continue
-		}
 		if len(j.Status.Conditions) == 0 {
-			hlog.Infof("[KanikoJobMonitor]job %v is still running", j.Name)
+			hlog.Infof("[BuildJobMonitor]job %v is still running", j.Name)
 			continue
 		}
 
-		UUID, ok := j.ObjectMeta.Annotations["JOB_UUID"]
+		UUID, ok := j.ObjectMeta.Annotations[utils.JobUUIDAnnotation]
 		if !ok {
-			hlog.Error("[KanikoJobMonitor]failed to get job's UUID")
+			hlog.Error("[BuildJobMonitor]failed to get job's UUID")
 			continue
 		}
-		token, ok := j.ObjectMeta.Annotations["USER_TOKEN"]
+		token, ok := j.ObjectMeta.Annotations[utils.UserTokenAnnotation]
 		if !ok {
-			hlog.Error("[KanikoJobMonitor]failed to get user's token")
+			hlog.Error("[BuildJobMonitor]failed to get user's token")
 			continue
 		}
-		creator, ok := j.ObjectMeta.Annotations["JOB_CREATOR"]
+		creator, ok := j.ObjectMeta.Annotations[utils.JobCreatorAnnotation]
 		if !ok {
-			hlog.Error("[KanikoJobMonitor]failed to get job's creator")
+			hlog.Error("[BuildJobMonitor]failed to get job's creator")
 			continue
 		}
 
-		hlog.Infof("[KanikoJobMonitor]job name: %v, job status: %v", j.Name, j.Status.Conditions[0].Type)
+		hlog.Infof("[BuildJobMonitor]job name: %v, job status: %v", j.Name, j.Status.Conditions[0].Type)
+		// a build job is deleted only once its result is reported, one failing job doesn't stop the others
 		if j.Status.Conditions[0].Type == batchv1.JobComplete {
 			digest, err := getImageDigest(ctx, clientSet, j.Name, client.RunningNameSpace)
 			if err != nil {
-				hlog.Errorf("[KanikoJobMonitor]failed to get image digest: %+v", err)
-				return err
+				hlog.Errorf("[BuildJobMonitor]failed to get image digest of job %v: %+v", j.Name, err)
+				continue
 			}
-			err = updateJobStatus(creator, UUID, token, digest, int64(job.JobStatus_VMWaiting))
+			err = updateJobStatus(creator, UUID, token, digest, "", int64(job.JobStatus_VMWaiting))
 			if err != nil {
-				return err
+				hlog.Errorf("[BuildJobMonitor]failed to report image of job %v: %+v", j.Name, err)
+				continue
 			}
-
-			err = deleteJob(ctx, clientSet, j.Name, client.RunningNameSpace)
-			if err != nil {
-				hlog.Errorf("[KanikoJobMonitor]failed to delete job: %+v", err)
-				return err
-			}
-			// deleteBuildContext(ctx, creator, UUID)
 		} else if j.Status.Conditions[0].Type == batchv1.JobFailed {
-			err = updateJobStatus(creator, UUID, token, "", int64(job.JobStatus_ImageBuildingFailed))
+			err = updateJobStatus(creator, UUID, token, "", j.Status.Conditions[0].Message, int64(job.JobStatus_ImageBuildingFailed))
 			if err != nil {
-				return err
+				hlog.Errorf("[BuildJobMonitor]failed to report failure of job %v: %+v", j.Name, err)
+				continue
 			}
-			// deleteBuildContext(ctx, creator, UUID)
+		} else {
+			continue
+		}
+		err = deleteJob(ctx, clientSet, j.Name, client.RunningNameSpace)
+		if err != nil {
+			hlog.Errorf("[BuildJobMonitor]failed to delete job %v: %+v", j.Name, err)
 		}
 	}
 	return nil
 }
 
 func deleteJob(ctx context.Context, clientSet *kubernetes.Clientset, jobName string, namespace string) error {
-	hlog.Infof("[KanikoJobMonitor]delete job: %v", jobName)
+	hlog.Infof("[BuildJobMonitor]delete job: %v", jobName)
 	deletePolicy := metav1.DeletePropagationForeground
 	if err := clientSet.BatchV1().Jobs(namespace).Delete(ctx, jobName, metav1.DeleteOptions{
 		PropagationPolicy: &deletePolicy,
@@ -112,6 +111,7 @@ func deleteJob(ctx context.Context, clientSet *kubernetes.Clientset, jobName str
 	return nil
 }
 
+// getImageDigest reads the digest the builder wrote to the termination message of the build container.
 func getImageDigest(ctx context.Context, clientSet *kubernetes.Clientset, jobName string, namespace string) (string, error) {
 	pods, err := clientSet.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: "job-name=" + jobName,
@@ -119,56 +119,38 @@ func getImageDigest(ctx context.Context, clientSet *kubernetes.Clientset, jobNam
 	if err != nil {
 		return "", errors.Wrap(err, "failed to list pod")
 	}
-	hlog.Infof("[KanikoJobMonitor] pods num: %d", len(pods.Items))
+	hlog.Infof("[BuildJobMonitor] pods num: %d", len(pods.Items))
 	for _, pod := range pods.Items {
-		req := clientSet.CoreV1().Pods(namespace).GetLogs(pod.Name, &corev1.PodLogOptions{})
-		logs, err := req.Stream(ctx)
-		if err != nil {
-			return "", errors.Wrap(err, "failed to read log stream")
-		}
-
-		digest, err := getDigestFromLog(logs)
-		if err != nil {
-			return "", err
-		}
-		_ = logs.Close()
+		digest := getDigestFromPodStatus(&pod)
 		if digest == "" {
 			continue
 		}
-		hlog.Infof("[KanikoJobMonitor]got image digest %v", digest)
+		hlog.Infof("[BuildJobMonitor]got image digest %v", digest)
 		return digest, nil
 	}
 	return "", errors.New("failed to read digest")
 }
 
-func getDigestFromLog(reader io.Reader) (string, error) {
-	var lastLine string
-	scanner := bufio.NewScanner(reader)
-	// get the last line
-	for scanner.Scan() {
-		lastLine = scanner.Text()
-	}
-	if scanner.Err() != nil {
-		return "", errors.Wrap(scanner.Err(), "failed to get last line")
-	}
-	r, _ := regexp.Compile(`sha256:[a-z0-9]+`)
-	digest := r.FindString(lastLine)
-	return digest, nil
+func getDigestFromPodStatus(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != utils.BuildContainerName || status.State.Terminated == nil {
+			continue
+		}
+		if status.State.Terminated.ExitCode != 0 {
+			continue
+		}
+		digest, err := utils.ParseBuildDigest(status.State.Terminated.Message)
+		if err != nil {
+			hlog.Errorf("[BuildJobMonitor]failed to parse termination message of pod %v: %v", pod.Name, err)
+			continue
+		}
+		return digest
+	}
+	return ""
 }
 
-func updateJobStatus(creator, UUID, token, digest string, status int64) error {
+func updateJobStatus(creator, UUID, token, digest, reason string, status int64) error {
 	ctx := context.Background()
-	req := &protocol.Request{}
-	res := &protocol.Response{}
-	req.SetMethod(consts.MethodPost)
-	req.Header.SetContentTypeBytes([]byte("application/json"))
-	apiHost := os.Getenv("DATA_CLEAN_ROOM_HOST")
-	if apiHost == "" {
-		return errors.New("DATA_CLEAN_ROOM_HOST environment variable not set")
-	}
-	req.SetRequestURI(apiHost + client.UpdatePath)
-	req.SetHeader("Authorization", token)
-
 	attestationReport := ""
 	var err error
 	if job.JobStatus(status) == job.JobStatus_VMFinished {
@@ -185,34 +167,49 @@ func updateJobStatus(creator, UUID, token, digest string, status int64) error {
 		DockerImage:       config.GetJobDockerImageFull(creator, UUID),
 		Creator:           creator,
 		AttestationToken:  attestationReport,
+		FailureReason:     reason,
 	}
-	jsonByte, _ := json.Marshal(request)
-	req.SetBody(jsonByte)
-	err = client.HTTPClient.Do(ctx, req, res)
+	return postAPI(ctx, client.UpdatePath, token, request, &job.UpdateJobStatusResponse{})
+}
+
+// postAPI posts the request to the API and decodes its response, it fails unless the API handled the request
+func postAPI(ctx context.Context, path string, token string, request interface{}, response interface{}) error {
+	apiHost := os.Getenv("DATA_CLEAN_ROOM_HOST")
+	if apiHost == "" {
+		return errors.New("DATA_CLEAN_ROOM_HOST environment variable not set")
+	}
+	body, err := json.Marshal(request)
 	if err != nil {
-		return errors.Wrap(err, "failed to update job status")
+		return errors.Wrap(err, "failed to marshal request")
 	}
-	if res.StatusCode() != 200 {
-		return errors.Wrap(err, "failed to update job status")
+	req := &protocol.Request{}
+	res := &protocol.Response{}
+	req.SetMethod(consts.MethodPost)
+	req.Header.SetContentTypeBytes([]byte("application/json"))
+	req.SetRequestURI(apiHost + path)
+	req.SetHeader("Authorization", token)
+	req.SetBody(body)
+	if err = client.HTTPClient.Do(ctx, req, res); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to call %s", path))
 	}
-	hlog.Infof("resp %v", res.Body())
-	resp := &job.UpdateJobStatusResponse{}
-	err = json.Unmarshal(res.Body(), resp)
-	if err != nil {
-		return errors.Wrap(err, "failed to update job status")
+	if res.StatusCode() != consts.StatusOK {
+		return fmt.Errorf("failed to call %s, status %d: %s", path, res.StatusCode(), res.Body())
 	}
-	if resp.Code != 0 {
-		return fmt.Errorf("fail to update job status, response: %s", resp.Msg)
+	hlog.Debugf("[Monitor] response of %s: %s", path, res.Body())
+	result := &struct {
+		Code int32  `json:"code"`
+		Msg  string `json:"msg"`
+	}{}
+	if err = json.Unmarshal(res.Body(), result); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to parse response of %s", path))
 	}
-	return nil
-}
-
-func deleteBuildContext(ctx context.Context, creator string, UUID string) {
-	provider := cloud.GetCloudProvider(ctx)
-	err := provider.DeleteFile(config.GetBuildContextPath(creator, UUID))
-	if err != nil {
-		hlog.Errorf("[KubernetesBuildService]failed to delete build context %+v", err)
+	if result.Code != errno.SuccessCode {
+		return fmt.Errorf("failed to call %s, response: %s", path, result.Msg)
+	}
+	if err = json.Unmarshal(res.Body(), response); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to parse response of %s", path))
 	}
+	return nil
 }
 
 func getJobAttestationReport(ctx context.Context, creator, UUID string) (string, error) {
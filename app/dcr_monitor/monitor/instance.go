@@ -16,20 +16,15 @@ package monitor
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_api/biz/model/job"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_monitor/client"
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/cloud"
 )
 
-func getCreator(token string) (string, error) {
-	parts := strings.Split(token, "-")
-	return parts[0], nil
-}
-
 func CheckTeeInstance(ctx context.Context) error {
 	hlog.Info("[InstancesMonitor] start to monitor instances' status")
 	provider := cloud.GetCloudProvider(ctx)
@@ -43,43 +38,85 @@ func CheckTeeInstance(ctx context.Context) error {
 		token := instance.Token
 		status := instance.Status
 		UUID := instance.UUID
-		if UUID == "" {
+		// the job of the instance and its creator come from the API, instances of unknown jobs are left alone
+		j, err := queryJobState(ctx, UUID, token)
+		if err != nil {
+			hlog.Errorf("[InstancesMonitor] failed to query job %s of instance %s: %+v", UUID, instance.Name, err)
 			continue
 		}
-		creator, err := getCreator(instance.Name)
-		if err != nil {
-			hlog.Errorf("[InstancesMonitor] failed to get creator info %+v", err)
+		if j.InstanceName != instance.Name {
+			// a stopped instance of an earlier attempt of the job is deleted without reporting its status
+			hlog.Warnf("[InstancesMonitor] instance %s is not the instance %s of job %s", instance.Name, j.InstanceName, UUID)
+			if status == cloud.INSTANCE_TERMINATED {
+				if err = provider.DeleteInstance(instance.Zone, instance.Name); err != nil {
+					return err
+				}
+			}
 			continue
 		}
+		if j.Ended {
+			// the job ended without its VM, such as when it was cancelled, there is nothing to report
+			hlog.Infof("[InstancesMonitor] job %s of instance %s is %s, delete the instance", UUID, instance.Name, j.JobStatus)
+			if err = provider.DeleteInstance(instance.Zone, instance.Name); err != nil {
+				return err
+			}
+			continue
+		}
+		creator := j.Creator
 		timeCreation := instance.CreationTime
 		layout := "2006-01-02T15:04:05.999999999Z07:00"
 		formattedTimeCreation, err := time.Parse(layout, timeCreation)
 		jobStuck := time.Since(formattedTimeCreation) > 6*time.Hour
 
 		if status == cloud.INSTANCE_TERMINATED {
-			err = updateTeeInstanceStatus(creator, UUID, token, int64(job.JobStatus_VMFinished))
+			jobStatus := job.JobStatus_VMFinished
+			if instance.Spot {
+				// a preempted spot instance is stopped like a finished one, the API relaunches its job
+				preempted, err := provider.IsInstancePreempted(instance.Zone, instance.Name)
+				if err != nil {
+					return err
+				}
+				if preempted {
+					hlog.Infof("[InstancesMonitor] instance %s of job %s was preempted", instance.Name, UUID)
+					jobStatus = job.JobStatus_VMPreempted
+				}
+			}
+			err = updateTeeInstanceStatus(creator, UUID, token, int64(jobStatus))
 			if err != nil {
 				return err
 			}
-			hlog.Info("[InstancesMonitor]Successfully updated the instance status to finished")
-			err := provider.DeleteInstance(instance.Name)
+			hlog.Infof("[InstancesMonitor]Successfully updated the instance status to %v", jobStatus)
+			err := provider.DeleteInstance(instance.Zone, instance.Name)
 			if err != nil {
 				return err
 			}
-
+			continue
 		}
 		if jobStuck {
 			hlog.Infof("[InstancesMonitor] job %v has benn stucked more than 6 hours", UUID)
-			err = updateTeeInstanceStatus(creator, UUID, token, int64(job.JobStatus_VMFinished))
+			err = updateTeeInstanceStatus(creator, UUID, token, int64(job.JobStatus_TimedOut))
 			if err != nil {
 				return err
 			}
-			return provider.DeleteInstance(instance.Name)
+			return provider.DeleteInstance(instance.Zone, instance.Name)
 		}
 	}
 	return nil
 }
 
+func queryJobState(ctx context.Context, UUID string, token string) (*job.QueryJobStateResponse, error) {
+	stage1Token, err := convertTokenToStage1(token)
+	if err != nil {
+		return nil, err
+	}
+	res := &job.QueryJobStateResponse{}
+	err = postAPI(ctx, client.StatePath, stage1Token, &job.QueryJobStateRequest{UUID: UUID}, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func convertTokenToStage1(stage2Token string) (string, error) {
 	// TODO add authentication
 	return stage2Token, nil
@@ -90,6 +127,6 @@ func updateTeeInstanceStatus(creator, UUID, token string, status int64) error {
 	if err != nil {
 		return err
 	}
-	err = updateJobStatus(creator, UUID, stage1Token, "", status)
+	err = updateJobStatus(creator, UUID, stage1Token, "", "", status)
 	return err
 }
@@ -24,12 +24,16 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/pkg/errors"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/attestation"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/manifest"
 )
 
-const TikTokAudience = "https://research.tiktok.com/"
 const TokenFilename = "custom_token"
 
 type CustomToken struct {
@@ -38,9 +42,9 @@ type CustomToken struct {
 	TokenType string   `json:"token_type"`
 }
 
-func generateCustomAttestationToken(nonce string) ([]byte, error) {
+func generateCustomAttestationToken(audience string, nonce string) ([]byte, error) {
 	request := CustomToken{
-		Audience:  TikTokAudience,
+		Audience:  audience,
 		Nonces:    []string{nonce},
 		TokenType: "OIDC",
 	}
@@ -77,11 +81,91 @@ func requireParameter(name string, para string) {
 	}
 }
 
+// buildCommitment commits to the job, the image it runs, the datasets fetched into datasets/<name>/,
+// the output manifest written by inspect_tool and the encrypted notebook
+func buildCommitment(jobUUID string, datasets []string, manifestFileName string, encryptedOutput string) (*attestation.Commitment, error) {
+	launcherToken, err := os.ReadFile(attestation.LauncherTokenPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read launcher token")
+	}
+	claims, err := attestation.ParseUnverifiedClaims(string(launcherToken))
+	if err != nil {
+		return nil, err
+	}
+	c := &attestation.Commitment{
+		JobUUID:     jobUUID,
+		ImageDigest: claims.ImageDigest(),
+		Datasets:    []attestation.DatasetVersion{},
+	}
+	for _, name := range datasets {
+		m, err := manifest.HashDir(filepath.Join("datasets", name))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to hash dataset %s", name)
+		}
+		c.Datasets = append(c.Datasets, attestation.DatasetVersion{Name: name, Sha256: m.Root})
+	}
+	content, err := os.ReadFile(manifestFileName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read manifest")
+	}
+	m, err := manifest.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	c.ManifestRoot = m.Root
+	c.EncryptedOutputSha256, err = manifest.HashFile(encryptedOutput)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hash encrypted output")
+	}
+	return c, nil
+}
+
 func main() {
-	nonce := flag.String("nonce", "", "The nonce to generate custom token")
+	err := config.InitConfig()
+	if err != nil {
+		fmt.Printf("ERROR: failed to init config %+v \n", err)
+		os.Exit(1)
+	}
+
+	nonce := flag.String("nonce", "", "The nonce to generate custom token, the hash of the commitment if not given")
+	audience := flag.String("audience", config.GetAttestationAudience(), "The audience of the custom token")
+	jobUUID := flag.String("job", "", "The UUID of the job")
+	datasets := flag.String("datasets", "", "Comma separated datasets of the job, fetched into datasets/<name>/")
+	manifestFileName := flag.String("manifest", "", "The output manifest written by inspect_tool")
+	encryptedOutput := flag.String("encrypted-output", "", "The encrypted notebook")
+	commitmentFileName := flag.String("commitment", "", "The commitment whose SHA-256 hash is the nonce")
 	flag.Parse()
-	requireParameter("nonce", *nonce)
-	customToken, err := generateCustomAttestationToken(*nonce)
+	requireParameter("audience", *audience)
+	if *nonce == "" {
+		requireParameter("job", *jobUUID)
+		requireParameter("manifest", *manifestFileName)
+		requireParameter("encrypted-output", *encryptedOutput)
+		requireParameter("commitment", *commitmentFileName)
+		names := []string{}
+		if *datasets != "" {
+			names = strings.Split(*datasets, ",")
+		}
+		c, err := buildCommitment(*jobUUID, names, *manifestFileName, *encryptedOutput)
+		if err != nil {
+			fmt.Printf("ERROR: failed to build commitment %+v \n", err)
+			log.Fatal(err)
+		}
+		*nonce, err = c.Nonce()
+		if err != nil {
+			fmt.Printf("ERROR: failed to hash commitment %+v \n", err)
+			log.Fatal(err)
+		}
+		content, err := json.Marshal(c)
+		if err != nil {
+			fmt.Printf("ERROR: failed to marshal commitment %+v \n", err)
+			log.Fatal(err)
+		}
+		if err = os.WriteFile(*commitmentFileName, content, 0644); err != nil {
+			fmt.Printf("ERROR: failed to write commitment %+v \n", err)
+			log.Fatal(err)
+		}
+	}
+	customToken, err := generateCustomAttestationToken(*audience, *nonce)
 	if err != nil {
 		fmt.Printf("ERROR: failed to generate custom token %+v \n", err)
 		panic(err)
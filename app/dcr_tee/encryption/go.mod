@@ -4,6 +4,7 @@ go 1.22.0
 
 require (
 	cloud.google.com/go/kms v1.17.1
+	cloud.google.com/go/storage v1.41.0
 	github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg v0.0.1
 	google.golang.org/api v0.180.0
 )
@@ -18,7 +19,6 @@ require (
 	cloud.google.com/go/compute/metadata v0.3.0 // indirect
 	cloud.google.com/go/iam v1.1.8 // indirect
 	cloud.google.com/go/longrunning v0.5.7 // indirect
-	cloud.google.com/go/storage v1.41.0 // indirect
 	github.com/bytedance/go-tagexpr/v2 v2.9.2 // indirect
 	github.com/bytedance/gopkg v0.0.0-20220413063733-65bf48ffb3a7 // indirect
 	github.com/bytedance/sonic v1.11.6 // indirect
@@ -4,10 +4,15 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
 	kms "cloud.google.com/go/kms/apiv1"
 	"cloud.google.com/go/kms/apiv1/kmspb"
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
@@ -24,6 +29,17 @@ const credentialConfig = `{
 	"service_account_impersonation_url": "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken"
 }`
 
+// the dataset keys are granted to the attested identity of the job directly, there is no service account to impersonate
+const directCredentialConfig = `{
+	"type": "external_account",
+	"audience": "//iam.googleapis.com/%s",
+	"subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+	"token_url": "https://sts.googleapis.com/v1/token",
+	"credential_source": {
+	  "file": "/run/container_launcher/attestation_verifier_claims_token"
+	}
+}`
+
 func requireParameter(name string, para string) {
 	if para == "" {
 		fmt.Printf("ERROR: %s parameter is required \n", name)
@@ -51,6 +67,83 @@ func encryptBytes(ctx context.Context, keyName string, trustedServiceAccountEmai
 	return encryptResponse.Ciphertext, nil
 }
 
+func decryptBytes(ctx context.Context, keyName string, wippro string, encryptedData []byte) ([]byte, error) {
+	cc := fmt.Sprintf(directCredentialConfig, wippro)
+	kmsClient, err := kms.NewKeyManagementClient(ctx, option.WithCredentialsJSON([]byte(cc)))
+	if err != nil {
+		return nil, fmt.Errorf("creating a new KMS client with federated credentials: %w", err)
+	}
+
+	decryptRequest := &kmspb.DecryptRequest{
+		Name:       keyName,
+		Ciphertext: encryptedData,
+	}
+	decryptResponse, err := kmsClient.Decrypt(ctx, decryptRequest)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt source data: %w", err)
+	}
+
+	return decryptResponse.Plaintext, nil
+}
+
+// fetchDatasets downloads the encrypted data of each dataset and decrypts it into datasets/<name>/,
+// the path the notebook read the stage-1 preview from
+func fetchDatasets(ctx context.Context, names []string, wippro string) error {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("creating a new storage client: %w", err)
+	}
+	defer client.Close()
+	bucket := client.Bucket(config.GetBucket())
+	for _, name := range names {
+		keyName := config.GetKeyFullName(config.GetDatasetKey(name))
+		localDir := filepath.Join("datasets", name)
+		// the preview is replaced by the real data
+		if err = os.RemoveAll(localDir); err != nil {
+			return fmt.Errorf("removing preview of dataset %s: %w", name, err)
+		}
+		if err = os.MkdirAll(localDir, 0755); err != nil {
+			return fmt.Errorf("creating directory of dataset %s: %w", name, err)
+		}
+		prefix := config.GetDatasetStoragePath(name)
+		it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+		for {
+			attrs, err := it.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("listing data of dataset %s: %w", name, err)
+			}
+			relPath := strings.TrimPrefix(attrs.Name, prefix)
+			if relPath == "" || strings.HasSuffix(relPath, "/") {
+				continue
+			}
+			reader, err := bucket.Object(attrs.Name).NewReader(ctx)
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", attrs.Name, err)
+			}
+			encryptedData, err := io.ReadAll(reader)
+			reader.Close()
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", attrs.Name, err)
+			}
+			data, err := decryptBytes(ctx, keyName, wippro, encryptedData)
+			if err != nil {
+				return err
+			}
+			localPath := filepath.Join(localDir, filepath.FromSlash(strings.TrimSuffix(relPath, ".enc")))
+			if err = os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+				return fmt.Errorf("creating directory of %s: %w", localPath, err)
+			}
+			if err = os.WriteFile(localPath, data, 0644); err != nil {
+				return fmt.Errorf("writing %s: %w", localPath, err)
+			}
+		}
+	}
+	return nil
+}
+
 func main() {
 	err := config.InitConfig()
 	if err != nil {
@@ -62,13 +155,22 @@ func main() {
 	inputFileName := flag.String("input", "", "The input file to be encrypted")
 	outputFileName := flag.String("output", "", "The encrypted file")
 	impersonationServiceAccount := flag.String("impersonation", "", "The impersonation service account it used")
+	jobUUID := flag.String("job", "", "The UUID of the job, its workload identity pool provider is used")
+	dataset := flag.String("dataset", "", "Decrypt the input with the key of the dataset, the dataset must be declared by the job")
+	datasets := flag.String("fetch-datasets", "", "Comma separated datasets to fetch and decrypt into datasets/<name>/")
 	flag.Parse()
-	requireParameter("user", *user)
+	requireParameter("job", *jobUUID)
+	wipProvider := config.GetWipProviderFullName(config.GetJobWipProvider(*jobUUID))
+	if *datasets != "" {
+		err = fetchDatasets(context.Background(), strings.Split(*datasets, ","), wipProvider)
+		if err != nil {
+			fmt.Printf("ERROR: failed to fetch datasets %+v \n", err)
+			os.Exit(1)
+		}
+		return
+	}
 	requireParameter("input", *inputFileName)
 	requireParameter("output", *outputFileName)
-	requireParameter("impersonation", *outputFileName)
-	keyName := config.GetKeyFullName(config.GetUserKey(*user))
-	wipProvider := config.GetWipProviderFullName(config.GetUserWipProvider(*user))
 
 	sourceData, err := os.ReadFile(*inputFileName)
 	if err != nil {
@@ -76,12 +178,25 @@ func main() {
 		return
 	}
 
-	encryptedData, err := encryptBytes(context.Background(), keyName, *impersonationServiceAccount, wipProvider, sourceData)
-	if err != nil {
-		fmt.Printf("ERROR: encrypt output %+v \n", err)
-		return
+	var outputData []byte
+	if *dataset != "" {
+		keyName := config.GetKeyFullName(config.GetDatasetKey(*dataset))
+		outputData, err = decryptBytes(context.Background(), keyName, wipProvider, sourceData)
+		if err != nil {
+			fmt.Printf("ERROR: decrypt dataset %+v \n", err)
+			return
+		}
+	} else {
+		requireParameter("user", *user)
+		requireParameter("impersonation", *outputFileName)
+		keyName := config.GetKeyFullName(config.GetUserKey(*user))
+		outputData, err = encryptBytes(context.Background(), keyName, *impersonationServiceAccount, wipProvider, sourceData)
+		if err != nil {
+			fmt.Printf("ERROR: encrypt output %+v \n", err)
+			return
+		}
 	}
-	err = os.WriteFile(*outputFileName, encryptedData, 0644)
+	err = os.WriteFile(*outputFileName, outputData, 0644)
 	if err != nil {
 		fmt.Printf("ERROR: failed to write output to file %+v", err)
 		return
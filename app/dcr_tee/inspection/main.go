@@ -0,0 +1,133 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/inspection"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/manifest"
+)
+
+// readSample samples the tabular files of the datasets fetched into datasets/<name>/
+func readSample(names []string, maxRows int) (*inspection.Sample, error) {
+	tables := []*inspection.Table{}
+	for _, name := range names {
+		err := filepath.WalkDir(filepath.Join("datasets", name), func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			table, err := inspection.ReadTable(path, content)
+			if err != nil {
+				// files the inspection can't read are not sampled
+				fmt.Printf("WARNING: %v \n", err)
+				return nil
+			}
+			if table != nil {
+				tables = append(tables, table)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading dataset %s: %w", name, err)
+		}
+	}
+	return inspection.NewSample(tables, maxRows), nil
+}
+
+func requireParameter(name string, para string) {
+	if para == "" {
+		fmt.Printf("ERROR: %s parameter is required \n", name)
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+}
+
+func main() {
+	err := config.InitConfig()
+	if err != nil {
+		fmt.Printf("ERROR: failed to init config %+v \n", err)
+		os.Exit(1)
+	}
+
+	outputs := flag.String("outputs", "", "Comma separated output files and directories of the job")
+	datasets := flag.String("datasets", "", "Comma separated datasets of the job, fetched into datasets/<name>/")
+	reportFileName := flag.String("report", "", "The inspection report")
+	manifestFileName := flag.String("manifest", "", "The manifest of the inspected outputs, the attestation token commits to its root hash")
+	flag.Parse()
+	requireParameter("outputs", *outputs)
+	requireParameter("report", *reportFileName)
+	requireParameter("manifest", *manifestFileName)
+
+	conf := config.GetEgressConfig()
+	names := []string{}
+	if *datasets != "" {
+		names = strings.Split(*datasets, ",")
+	}
+	sample, err := readSample(names, conf.SampleRows)
+	if err != nil {
+		fmt.Printf("ERROR: failed to sample datasets %+v \n", err)
+		os.Exit(1)
+	}
+	// the other checks run in the API, only the comparison with the input data needs the TEE
+	limits := inspection.Limits{VerbatimMinMatches: conf.VerbatimMinMatches}
+	files, err := manifest.ListFiles(strings.Split(*outputs, ","))
+	if err != nil {
+		fmt.Printf("ERROR: failed to list outputs %+v \n", err)
+		os.Exit(1)
+	}
+	// the manifest hashes the same bytes that are inspected
+	reports := []*inspection.Report{}
+	contents := map[string][]byte{}
+	for _, output := range files {
+		content, err := os.ReadFile(output)
+		if err != nil {
+			fmt.Printf("ERROR: failed to read output %+v \n", err)
+			os.Exit(1)
+		}
+		contents[output] = content
+		reports = append(reports, inspection.Inspect(output, int64(len(content)), content, limits, sample))
+	}
+	m, err := manifest.New(contents)
+	if err != nil {
+		fmt.Printf("ERROR: failed to build manifest %+v \n", err)
+		os.Exit(1)
+	}
+	writeJSON(*reportFileName, reports)
+	writeJSON(*manifestFileName, m)
+}
+
+func writeJSON(fileName string, v interface{}) {
+	content, err := json.Marshal(v)
+	if err != nil {
+		fmt.Printf("ERROR: failed to marshal %s %+v \n", fileName, err)
+		os.Exit(1)
+	}
+	if err = os.WriteFile(fileName, content, 0644); err != nil {
+		fmt.Printf("ERROR: failed to write %s %+v \n", fileName, err)
+		os.Exit(1)
+	}
+}
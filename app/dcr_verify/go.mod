@@ -0,0 +1,7 @@
+module github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/app/dcr_verify
+
+go 1.22.0
+
+require github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg v0.0.1
+
+replace github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg => ../../pkg
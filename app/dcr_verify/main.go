@@ -0,0 +1,174 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/attestation"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/manifest"
+)
+
+func requireParameter(flags *flag.FlagSet, name string, para string) error {
+	if para == "" {
+		flags.PrintDefaults()
+		return fmt.Errorf("%s parameter is required", name)
+	}
+	return nil
+}
+
+func listOutputs(entries []string) (map[string]string, error) {
+	outputs := map[string]string{}
+	paths := []string{}
+	for _, e := range entries {
+		if p, file, ok := strings.Cut(e, "="); ok {
+			outputs[p] = file
+			continue
+		}
+		paths = append(paths, e)
+	}
+	files, err := manifest.ListFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		outputs[f] = f
+	}
+	return outputs, nil
+}
+
+func loadKeys(jwksFile string, issuer string, saveFile string) (attestation.KeySet, error) {
+	var content []byte
+	var err error
+	if jwksFile != "" {
+		content, err = os.ReadFile(jwksFile)
+	} else {
+		content, err = attestation.FetchJWKS(context.Background(), issuer)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if saveFile != "" {
+		if err = os.WriteFile(saveFile, content, 0644); err != nil {
+			return nil, err
+		}
+	}
+	return attestation.ParseJWKS(content)
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout))
+}
+
+// run verifies the outputs of a job and prints the verdict, it returns the exit code of the tool:
+// 0 if the outputs are verified, 1 if a check failed and 2 if the verification couldn't run
+func run(args []string, stdout io.Writer) int {
+	verdict, jsonOutput, err := verify(args)
+	if err != nil {
+		fmt.Fprintf(stdout, "ERROR: %+v \n", err)
+		return 2
+	}
+	if jsonOutput {
+		content, err := json.MarshalIndent(verdict, "", "  ")
+		if err != nil {
+			fmt.Fprintf(stdout, "ERROR: failed to marshal verdict %+v \n", err)
+			return 2
+		}
+		fmt.Fprintln(stdout, string(content))
+	} else {
+		fmt.Fprint(stdout, verdict.String())
+	}
+	if !verdict.Verified {
+		return 1
+	}
+	return 0
+}
+
+func verify(args []string) (*attestation.Verdict, bool, error) {
+	flags := flag.NewFlagSet("dcr-verify", flag.ContinueOnError)
+	tokenFile := flags.String("token", "", "The attestation token of the job, as returned by QueryJobAttestationReport")
+	commitmentFile := flags.String("commitment", "", "The commitment of the job, the nonce of the token is its hash")
+	manifestFile := flags.String("manifest", "", "The output manifest of the job, required to verify only some of the outputs")
+	outputs := flags.String("outputs", "", "Comma separated output files and directories, or <manifest path>=<file> entries")
+	imageDigest := flags.String("image-digest", "", "The expected digest of the image of the job")
+	hardwareModel := flags.String("hwmodel", "", "The expected hardware model of the job, such as GCP_AMD_SEV_SNP or GCP_INTEL_TDX")
+	networkMode := flags.String("network-mode", "", "The expected network mode of the job: private, proxy or open")
+	issuer := flags.String("issuer", attestation.DefaultIssuer, "The issuer of the token, its keys are fetched from its discovery document")
+	audience := flags.String("audience", "", "The expected audience of the token")
+	jwksFile := flags.String("jwks", "", "A pinned JWKS bundle of the issuer to verify offline")
+	saveJWKS := flags.String("save-jwks", "", "Save the keys used for the verification as a JWKS bundle")
+	checkExpiry := flags.Bool("check-expiry", false, "Reject expired tokens, tokens expire an hour after the job ran")
+	jsonOutput := flags.Bool("json", false, "Print the verdict as JSON")
+	if err := flags.Parse(args); err != nil {
+		return nil, false, err
+	}
+	required := [][2]string{{"token", *tokenFile}, {"commitment", *commitmentFile}, {"outputs", *outputs}, {"image-digest", *imageDigest}}
+	for _, p := range required {
+		if err := requireParameter(flags, p[0], p[1]); err != nil {
+			return nil, false, err
+		}
+	}
+
+	token, err := os.ReadFile(*tokenFile)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read token: %w", err)
+	}
+	content, err := os.ReadFile(*commitmentFile)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read commitment: %w", err)
+	}
+	c, err := attestation.ParseCommitment(content)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to parse commitment: %w", err)
+	}
+	var m *manifest.Manifest
+	if *manifestFile != "" {
+		content, err = os.ReadFile(*manifestFile)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to read manifest: %w", err)
+		}
+		m, err = manifest.Parse(content)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to parse manifest: %w", err)
+		}
+	}
+	files, err := listOutputs(strings.Split(*outputs, ","))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list outputs: %w", err)
+	}
+	keys, err := loadKeys(*jwksFile, *issuer, *saveJWKS)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to load the keys of the issuer: %w", err)
+	}
+
+	verifier := &attestation.Verifier{
+		Keys:          keys,
+		Token:         attestation.TokenOptions{Issuer: *issuer, Audience: *audience},
+		ImageDigest:   *imageDigest,
+		HardwareModel: *hardwareModel,
+		NetworkMode:   *networkMode,
+	}
+	if *checkExpiry {
+		verifier.Token.Now = time.Now()
+	}
+	return verifier.Verify(string(token), c, m, files), *jsonOutput, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/attestation"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/manifest"
+)
+
+const testImageDigest = "sha256:0123456789abcdef"
+
+// writeFixture writes a job output, its manifest, its commitment, a token signed with a local key and the pinned
+// JWKS of that key, and returns the arguments of the tool pointing to them
+func writeFixture(t *testing.T, expires time.Time) []string {
+	t.Helper()
+	dir := t.TempDir()
+	write := func(name string, content []byte) string {
+		file := filepath.Join(dir, name)
+		if err := os.WriteFile(file, content, 0600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+		return file
+	}
+	marshal := func(v interface{}) []byte {
+		content, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("failed to marshal: %v", err)
+		}
+		return content
+	}
+	output := []byte("count\n42\n")
+	m, err := manifest.New(map[string][]byte{"summary.csv": output})
+	if err != nil {
+		t.Fatalf("failed to build manifest: %v", err)
+	}
+	c := &attestation.Commitment{
+		JobUUID:               "job-uuid",
+		ImageDigest:           testImageDigest,
+		ManifestRoot:          m.Root,
+		EncryptedOutputSha256: "ef01",
+	}
+	nonce, err := c.Nonce()
+	if err != nil {
+		t.Fatalf("failed to compute nonce: %v", err)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	jwks := attestation.JWKS{Keys: []attestation.JWK{{
+		Kid: "test-key",
+		Kty: "RSA",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+	signed := base64.RawURLEncoding.EncodeToString(marshal(map[string]string{"alg": "RS256", "kid": "test-key"})) + "." +
+		base64.RawURLEncoding.EncodeToString(marshal(map[string]interface{}{
+			"iss":       attestation.DefaultIssuer,
+			"eat_nonce": nonce,
+			"exp":       expires.Unix(),
+			"submods":   map[string]interface{}{"container": map[string]string{"image_digest": testImageDigest}},
+		}))
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	return []string{
+		"--token", write("token", []byte(signed+"."+base64.RawURLEncoding.EncodeToString(signature))),
+		"--commitment", write("commitment.json", marshal(c)),
+		"--manifest", write("manifest.json", marshal(m)),
+		"--outputs", "summary.csv=" + write("summary.csv", output),
+		"--jwks", write("jwks.json", marshal(jwks)),
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		expires  time.Time
+		args     []string
+		wantCode int
+		wantOut  string
+	}{
+		{"verified", time.Now().Add(time.Hour), []string{"--image-digest", testImageDigest}, 0, "VERIFIED"},
+		{"wrong image", time.Now().Add(time.Hour), []string{"--image-digest", "sha256:other"}, 1, "FAIL image"},
+		{"expired token", time.Now().Add(-time.Hour), []string{"--image-digest", testImageDigest, "--check-expiry"}, 1, "FAIL token"},
+		{"archived expired token", time.Now().Add(-time.Hour), []string{"--image-digest", testImageDigest}, 0, "VERIFIED"},
+		{"missing image digest", time.Now().Add(time.Hour), nil, 2, "image-digest parameter is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			code := run(append(writeFixture(t, tt.expires), tt.args...), &out)
+			if code != tt.wantCode || !strings.Contains(out.String(), tt.wantOut) {
+				t.Fatalf("got exit code %d with output:\n%s\nwant %d with %q", code, out.String(), tt.wantCode, tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestRunJSON(t *testing.T) {
+	var out bytes.Buffer
+	args := append(writeFixture(t, time.Now().Add(time.Hour)), "--image-digest", testImageDigest, "--json")
+	if code := run(args, &out); code != 0 {
+		t.Fatalf("got exit code %d with output:\n%s", code, out.String())
+	}
+	var verdict attestation.Verdict
+	if err := json.Unmarshal(out.Bytes(), &verdict); err != nil {
+		t.Fatalf("failed to unmarshal verdict: %v", err)
+	}
+	if !verdict.Verified || verdict.JobUUID != "job-uuid" {
+		t.Fatalf("unexpected verdict %+v", verdict)
+	}
+}
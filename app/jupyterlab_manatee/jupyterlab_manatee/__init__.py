@@ -39,5 +39,6 @@ def _load_jupyter_server_extension(serverapp: jupyter_server.serverapp.ServerApp
     handlers = [
         (url_path_join(base_url, 'manatee', 'jobs'), DataCleanRoomJobHandler), 
         (url_path_join(base_url, 'manatee', 'output'), DataCleanRoomOutputHandler), (url_path_join(base_url, 'manatee', 'attestation'), DataCleanRoomAttestationHandler),
+        (url_path_join(base_url, 'manatee', 'datasets'), DataCleanRoomDatasetHandler),
     ]
     web_app.add_handlers('.*$', handlers)
\ No newline at end of file
@@ -34,6 +34,23 @@ def make_tarfile(output_filename, source_dir, arcname):
     with tarfile.open(output_filename, "w:gz") as tar:
         tar.add(source_dir, arcname=arcname, filter=ignore_hidden_files)
 
+DATASETS_DIR = 'datasets'
+PREVIEW_VERSION_FILE = '.preview'
+
+def get_mounted_datasets():
+    """
+    Return the "name@version" declarations of the dataset previews mounted in the work directory.
+    """
+    declarations = []
+    if not os.path.isdir(DATASETS_DIR):
+        return declarations
+    for name in sorted(os.listdir(DATASETS_DIR)):
+        version_file = os.path.join(DATASETS_DIR, name, PREVIEW_VERSION_FILE)
+        if os.path.isfile(version_file):
+            with open(version_file) as f:
+                declarations.append(name + '@' + f.read().strip())
+    return declarations
+
 def get_data_clean_room_url():
         # Get the value of an environment variable
         value = os.getenv('DATA_CLEAN_ROOM_HOST', '')
@@ -42,6 +59,51 @@ def get_data_clean_room_url():
             value = 'http://' + value
         return value
 
+def get_manatee_metadata(notebook_path):
+    """
+    Read the data clean room settings of the notebook, kept in its metadata under "manatee"
+    """
+    try:
+        with open(notebook_path) as f:
+            metadata = json.load(f).get('metadata', {})
+    except (OSError, ValueError):
+        return {}
+    return metadata.get('manatee', {})
+
+def get_privacy_budgets(notebook_path):
+    """
+    Read the DP mechanisms the notebook declares in its metadata as
+    {"manatee": {"privacy_budgets": ["dataset:mechanism:epsilon[:delta]", ...]}}
+    """
+    return get_manatee_metadata(notebook_path).get('privacy_budgets', [])
+
+def get_tee_type(notebook_path):
+    """
+    Read the confidential instance type the notebook selects in its metadata as
+    {"manatee": {"tee_type": "SEV" | "SEV_SNP" | "TDX"}}, the API default is used without it
+    """
+    return get_manatee_metadata(notebook_path).get('tee_type', '')
+
+def get_machine_profile(notebook_path):
+    """
+    Read the machine profile the notebook selects in its metadata as
+    {"manatee": {"profile": "medium"}}, the API default is used without it
+    """
+    return get_manatee_metadata(notebook_path).get('profile', '')
+
+def get_spot(notebook_path):
+    """
+    Read whether the notebook runs on a spot VM from its metadata as {"manatee": {"spot": true}}
+    """
+    return bool(get_manatee_metadata(notebook_path).get('spot', False))
+
+def get_network_mode(notebook_path):
+    """
+    Read the network mode the notebook selects in its metadata as
+    {"manatee": {"network_mode": "private" | "proxy" | "open"}}, the API default is used without it
+    """
+    return get_manatee_metadata(notebook_path).get('network_mode', '')
+
 # Developer should develop the authenticator within hub image to pass user token to the single user pod through environment variable.
 def get_user_token():
     token = os.getenv('USER_TOKEN', '')
@@ -69,7 +131,7 @@ class DataCleanRoomJobHandler(JupyterHandler):
     A Job Handler for Data Clean Room API.
     """
 
-    def _build_form_data(self, workspace_file, creator, jupyter_filename) -> FormData:
+    def _build_form_data(self, workspace_file, creator, jupyter_filename, privacy_budgets, tee_type, profile, spot, network_mode) -> FormData:
         data = FormData()
         data.add_field('file',
                         value=open(workspace_file, 'rb'),
@@ -77,6 +139,18 @@ class DataCleanRoomJobHandler(JupyterHandler):
                         content_type='application/gzip')
         data.add_field('creator', creator)
         data.add_field('filename', jupyter_filename)
+        for declaration in get_mounted_datasets():
+            data.add_field('datasets', declaration)
+        for request in privacy_budgets:
+            data.add_field('privacy_budgets', request)
+        if tee_type:
+            data.add_field('tee_type', tee_type)
+        if profile:
+            data.add_field('profile', profile)
+        if spot:
+            data.add_field('spot', 'true')
+        if network_mode:
+            data.add_field('network_mode', network_mode)
         return data
 
     async def post_file(self, endpoint, body, workspace_filename, headers) -> str:
@@ -86,11 +160,11 @@ class DataCleanRoomJobHandler(JupyterHandler):
         url = url_path_join(get_data_clean_room_url(), endpoint)
         try:
             async with aiohttp.ClientSession() as session:
-                data = self._build_form_data(workspace_filename, body['creator'], body['filename'])
+                data = self._build_form_data(workspace_filename, body['creator'], body['filename'], body['privacy_budgets'], body['tee_type'], body['profile'], body['spot'], body['network_mode'])
                 async with session.post(url, data=data, headers=headers, allow_redirects=False) as response:
                     if response.status == HTTPStatus.TEMPORARY_REDIRECT:
                         # when redirect, post manually again
-                        data = self._build_form_data(workspace_filename, body['creator'], body['filename'])
+                        data = self._build_form_data(workspace_filename, body['creator'], body['filename'], body['privacy_budgets'], body['tee_type'], body['profile'], body['spot'], body['network_mode'])
                         redirect_url = url_path_join(get_data_clean_room_url(), response.headers['Location']) 
                         async with session.post(redirect_url, data=data, headers=headers) as redirect_resp:
                             return await redirect_resp.text()
@@ -116,6 +190,11 @@ class DataCleanRoomJobHandler(JupyterHandler):
 
         if "filename" not in request_body or "path" not in request_body:
             raise tornado.web.HTTPError(500, reason="Missing arguments")
+        request_body['privacy_budgets'] = get_privacy_budgets(request_body['path'])
+        request_body['tee_type'] = get_tee_type(request_body['path'])
+        request_body['profile'] = get_machine_profile(request_body['path'])
+        request_body['spot'] = get_spot(request_body['path'])
+        request_body['network_mode'] = get_network_mode(request_body['path'])
         # Pack the work directory into a tar archive
         tar_filename = "/tmp/workspace.tar.gz"
         make_tarfile(tar_filename, os.getcwd(), creator + '-workspace')
@@ -150,42 +229,63 @@ class DataCleanRoomOutputHandler(JupyterHandler):
         headers = {
             "Authorization" : get_user_token()
         }
-        attr_resp_str = await make_proxied_post_request(self.log, "v1/job/output/attrs", request_body, headers)
-        attr_resp = json.loads(attr_resp_str)
-        if attr_resp['code'] != 0:
-            self.finish(attr_resp_str)
-            return 
-        filename = attr_resp["filename"]
-        filesize = attr_resp["size"]
-    
-        await self.download_file("v1/job/output/download", request_body, headers, filename, filesize)
+        list_resp_str = await make_proxied_post_request(self.log, "v1/job/output/list", request_body, headers)
+        list_resp = json.loads(list_resp_str)
+        if list_resp['code'] != 0:
+            self.finish(list_resp_str)
+            return
+        # every released output of the job is downloaded
+        filenames = []
+        for output in list_resp.get('outputs') or []:
+            if output['status'] not in ('released', 'redacted'):
+                continue
+            request_body['filename'] = output['filename']
+            attr_resp_str = await make_proxied_post_request(self.log, "v1/job/output/attrs", request_body, headers)
+            attr_resp = json.loads(attr_resp_str)
+            if attr_resp['code'] != 0:
+                self.finish(attr_resp_str)
+                return
+            filename = attr_resp["filename"]
+            filesize = attr_resp["size"]
+            err = await self.download_file("v1/job/output/download", request_body, headers, filename, filesize)
+            if err is not None:
+                self.finish(err)
+                return
+            filenames.append(filename)
+        if not filenames:
+            self.finish(json.dumps({
+                "code": 1,
+                "msg": "No output of the job is released yet"
+            }).encode('utf-8'))
+            return
+        self.finish(json.dumps({
+            "code": 0,
+            "msg": "Success",
+            "filename": ", ".join(filenames)
+        }).encode('utf-8'))
 
     async def download_file(self, endpoint, request_body, headers, filename, filesize):
-        url = url_path_join(get_data_clean_room_url(), endpoint)
+        """
+        Download the file by chunks, return the error response if the download failed.
+        """
         offset = 0
         chunk = 1024 * 1024 * 3 # 3 MB
         request_body['chunk'] = chunk
         try:
-            async with aiohttp.ClientSession() as session:
-                with open(filename, 'wb') as f:
-                    while offset < filesize:
-                        request_body['offset'] = offset
-                        download_resp_str = await make_proxied_post_request(self.log, endpoint, request_body, headers)
-                        download_resp = json.loads(download_resp_str)
-                        if download_resp['code'] != 0:
-                            self.finish(download_resp)
-                            return 
-                        decoded_content = base64.b64decode(download_resp['content'])
-                        offset += len(decoded_content)
-                        f.write(decoded_content)
-                self.finish(json.dumps({
-                    "code": 0,
-                    "msg": "Success",
-                    "filename": filename
-                }).encode('utf-8'))
+            with open(filename, 'wb') as f:
+                while offset < filesize:
+                    request_body['offset'] = offset
+                    download_resp_str = await make_proxied_post_request(self.log, endpoint, request_body, headers)
+                    download_resp = json.loads(download_resp_str)
+                    if download_resp['code'] != 0:
+                        return download_resp
+                    decoded_content = base64.b64decode(download_resp['content'])
+                    offset += len(decoded_content)
+                    f.write(decoded_content)
+            return None
         except Exception as e:
             self.log.error(e)
-            raise tornado.web.HTTPError(500, reason="Failed to make a request to Data Clean Room API")  
+            raise tornado.web.HTTPError(500, reason="Failed to make a request to Data Clean Room API")
 
 class DataCleanRoomAttestationHandler(JupyterHandler):
     @tornado.web.authenticated
@@ -205,3 +305,83 @@ class DataCleanRoomAttestationHandler(JupyterHandler):
         }
 
         self.finish(await make_proxied_post_request(self, "v1/job/attestation/", request_body, headers))
+
+class DataCleanRoomDatasetHandler(JupyterHandler):
+    @tornado.web.authenticated
+    async def get(self):
+        """
+        List datasets with their previews from Data Clean Room API
+        """
+        creator = self.current_user.username
+
+        request_body = {
+            "page": int(self.get_argument("page", 1)),
+            "page_size": int(self.get_argument("page_size", 10)),
+        }
+
+        headers = {
+            "Authorization" : get_user_token()
+        }
+
+        datasets_resp_str = await make_proxied_post_request(self.log, "v1/dataset/query", request_body, headers)
+        datasets_resp = json.loads(datasets_resp_str)
+        if datasets_resp['code'] != 0:
+            self.finish(datasets_resp_str)
+            return
+        for dataset in datasets_resp.get('datasets') or []:
+            previews_resp = json.loads(await make_proxied_post_request(self.log, "v1/dataset/preview/query", {
+                "name": dataset['name'],
+                "creator": creator
+            }, headers))
+            dataset['previews'] = previews_resp.get('previews') or []
+        self.finish(json.dumps(datasets_resp).encode('utf-8'))
+
+    @tornado.web.authenticated
+    async def post(self):
+        """
+        Mount a dataset preview into the work directory, jobs submitted afterwards declare the dataset
+        """
+        request_body = json.loads(self.request.body.decode('utf-8'))
+        if "name" not in request_body or "version" not in request_body or "filename" not in request_body or "size" not in request_body:
+            raise tornado.web.HTTPError(500, reason="Missing arguments")
+        name = request_body['name']
+        version = int(request_body['version'])
+        # names are validated by the API, never let them escape the datasets directory
+        if os.path.basename(name) != name or os.path.basename(request_body['filename']) != request_body['filename']:
+            raise tornado.web.HTTPError(500, reason="Invalid arguments")
+
+        headers = {
+            "Authorization" : get_user_token()
+        }
+        download_body = {
+            "name": name,
+            "version": version,
+            "creator": self.current_user.username,
+            "chunk": 1024 * 1024 * 3 # 3 MB
+        }
+
+        dataset_dir = os.path.join(DATASETS_DIR, name)
+        os.makedirs(dataset_dir, exist_ok=True)
+        filename = os.path.join(dataset_dir, request_body['filename'])
+        offset = 0
+        filesize = int(request_body['size'])
+        with open(filename, 'wb') as f:
+            while offset < filesize:
+                download_body['offset'] = offset
+                download_resp_str = await make_proxied_post_request(self.log, "v1/dataset/preview/download", download_body, headers)
+                download_resp = json.loads(download_resp_str)
+                if download_resp['code'] != 0:
+                    self.finish(download_resp_str)
+                    return
+                decoded_content = base64.b64decode(download_resp['content'])
+                if len(decoded_content) == 0:
+                    break
+                offset += len(decoded_content)
+                f.write(decoded_content)
+        with open(os.path.join(dataset_dir, PREVIEW_VERSION_FILE), 'w') as f:
+            f.write(str(version))
+        self.finish(json.dumps({
+            "code": 0,
+            "msg": "Success",
+            "filename": filename
+        }).encode('utf-8'))
@@ -32,7 +32,13 @@ const statusMap: Map<number, {color: string, text: string}> = new Map([
     [5, {color: '#86909c', text: 'VM Finished'}],
     [6, {color: 'red', text: 'VM Killed'}],
     [7, {color: 'red', text: 'VM Failed'}],
-    [8, {color: 'gray', text: 'VM Other'}]
+    [8, {color: 'gray', text: 'VM Other'}],
+    [9, {color: 'orange', text: 'Pending Approval'}],
+    [10, {color: 'red', text: 'Approval Rejected'}],
+    [11, {color: 'orange', text: 'VM Preempted'}],
+    [12, {color: 'gray', text: 'Queued'}],
+    [13, {color: '#86909c', text: 'Cancelled'}],
+    [14, {color: 'red', text: 'Timed Out'}]
 ]);
 
 const columns: TableColumnProps[] = [
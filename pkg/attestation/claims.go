@@ -0,0 +1,110 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// LauncherTokenPath is where the Confidential Space launcher keeps the default attestation token of the workload
+const LauncherTokenPath = "/run/container_launcher/attestation_verifier_claims_token"
+
+// StringList is a claim holding a single string or an array of strings
+type StringList []string
+
+func (l *StringList) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*l = StringList{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+// Claims are the claims of a Confidential Space attestation token used by the data clean room
+type Claims struct {
+	Issuer    string     `json:"iss"`
+	Audience  StringList `json:"aud"`
+	Nonces    StringList `json:"eat_nonce"`
+	ExpiresAt int64      `json:"exp"`
+	NotBefore int64      `json:"nbf"`
+	IssuedAt  int64      `json:"iat"`
+	// HardwareModel is the confidential computing technology of the instance, such as GCP_INTEL_TDX
+	HardwareModel string `json:"hwmodel"`
+	Submods       struct {
+		Container struct {
+			ImageDigest string `json:"image_digest"`
+			// EnvOverride are the environment variables the launcher set from the instance metadata
+			EnvOverride map[string]string `json:"env_override"`
+		} `json:"container"`
+	} `json:"submods"`
+}
+
+// ImageDigest is the digest of the container image the token attests
+func (c *Claims) ImageDigest() string {
+	return c.Submods.Container.ImageDigest
+}
+
+// NetworkMode is the network mode of the instance the token attests
+func (c *Claims) NetworkMode() string {
+	return c.Submods.Container.EnvOverride["NETWORK_MODE"]
+}
+
+func (c *Claims) HasAudience(audience string) bool {
+	return slices.Contains(c.Audience, audience)
+}
+
+func (c *Claims) HasNonce(nonce string) bool {
+	return slices.Contains(c.Nonces, nonce)
+}
+
+// ParseUnverifiedClaims decodes the claims of a token without checking its signature,
+// only use it on tokens from a trusted source such as the launcher. Use VerifyToken on any other token
+func ParseUnverifiedClaims(token string) (*Claims, error) {
+	parts, err := splitToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return decodeClaims(parts[1])
+}
+
+func splitToken(token string) ([]string, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("attestation token is not a JWT")
+	}
+	return parts, nil
+}
+
+func decodeClaims(segment string) (*Claims, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode attestation token: %w", err)
+	}
+	var claims Claims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal attestation token claims: %w", err)
+	}
+	return &claims, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// commitmentDomain separates the commitments from any other SHA-256 hashed data, it changes with the format
+const commitmentDomain = "dcr-attestation-commitment-v1"
+
+// DatasetVersion identifies the data a job read from a dataset, Sha256 is the root hash of the files
+// of the dataset computed like the root hash of an output manifest
+type DatasetVersion struct {
+	Name   string `json:"name"`
+	Sha256 string `json:"sha256"`
+}
+
+// Commitment binds the attestation token of a job to the job, the image it ran, the data it read and the outputs
+// it wrote. The SHA-256 hash of its canonical form is the nonce of the token
+type Commitment struct {
+	JobUUID               string           `json:"job_uuid"`
+	ImageDigest           string           `json:"image_digest"`
+	Datasets              []DatasetVersion `json:"datasets"`
+	ManifestRoot          string           `json:"manifest_root"`
+	EncryptedOutputSha256 string           `json:"encrypted_output_sha256"`
+}
+
+// Canonical encodes the commitment as one "<field> <values>" line per field after the domain line,
+// the datasets are sorted by name
+func (c *Commitment) Canonical() ([]byte, error) {
+	datasets := make([]DatasetVersion, len(c.Datasets))
+	copy(datasets, c.Datasets)
+	sort.Slice(datasets, func(i, j int) bool {
+		return datasets[i].Name < datasets[j].Name
+	})
+	lines := [][]string{{"job_uuid", c.JobUUID}, {"image_digest", c.ImageDigest}}
+	for _, d := range datasets {
+		lines = append(lines, []string{"dataset", d.Name, d.Sha256})
+	}
+	lines = append(lines, []string{"manifest_root", c.ManifestRoot}, []string{"encrypted_output_sha256", c.EncryptedOutputSha256})
+	var buf bytes.Buffer
+	buf.WriteString(commitmentDomain + "\n")
+	for _, l := range lines {
+		for _, v := range l[1:] {
+			if err := checkValue(v); err != nil {
+				return nil, fmt.Errorf("%s: %w", l[0], err)
+			}
+		}
+		buf.WriteString(strings.Join(l, " ") + "\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// Nonce is the hex SHA-256 hash of the canonical commitment
+func (c *Commitment) Nonce() (string, error) {
+	canonical, err := c.Canonical()
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(canonical)
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// Names returns the names of the datasets of the commitment, sorted
+func (c *Commitment) Names() []string {
+	res := []string{}
+	for _, d := range c.Datasets {
+		res = append(res, d.Name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func ParseCommitment(content []byte) (*Commitment, error) {
+	var c Commitment
+	if err := json.Unmarshal(content, &c); err != nil {
+		return nil, fmt.Errorf("invalid commitment: %w", err)
+	}
+	if _, err := c.Canonical(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// checkValue rejects empty values and values with whitespace, they would make the canonical form ambiguous
+func checkValue(v string) error {
+	if v == "" || strings.ContainsAny(v, " \t\r\n") {
+		return fmt.Errorf("invalid commitment value %q", v)
+	}
+	return nil
+}
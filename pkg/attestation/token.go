@@ -0,0 +1,181 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultIssuer is the issuer of the Confidential Space attestation tokens
+const DefaultIssuer = "https://confidentialcomputing.googleapis.com"
+
+const discoveryPath = "/.well-known/openid-configuration"
+
+// JWK is a key of a JSON Web Key Set, the attestation tokens are signed with RS256 keys
+type JWK struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// KeySet maps the key ids of an issuer to its public signing keys
+type KeySet map[string]*rsa.PublicKey
+
+// ParseJWKS reads the RSA keys of a JSON Web Key Set, the document served at the jwks_uri of the issuer.
+// A saved copy of the document is a pinned key bundle for offline verification
+func ParseJWKS(content []byte) (KeySet, error) {
+	var jwks JWKS
+	if err := json.Unmarshal(content, &jwks); err != nil {
+		return nil, fmt.Errorf("invalid JWKS: %w", err)
+	}
+	keys := KeySet{}
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" || (k.Alg != "" && k.Alg != "RS256") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus of key %s: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent of key %s: %w", k.Kid, err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid exponent of key %s", k.Kid)
+		}
+		keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKS has no RS256 keys")
+	}
+	return keys, nil
+}
+
+// FetchJWKS downloads the JSON Web Key Set of an issuer through its OpenID discovery document
+func FetchJWKS(ctx context.Context, issuer string) ([]byte, error) {
+	content, err := httpGet(ctx, strings.TrimSuffix(issuer, "/")+discoveryPath)
+	if err != nil {
+		return nil, err
+	}
+	var discovery struct {
+		JwksURI string `json:"jwks_uri"`
+	}
+	if err = json.Unmarshal(content, &discovery); err != nil {
+		return nil, fmt.Errorf("invalid discovery document of %s: %w", issuer, err)
+	}
+	if discovery.JwksURI == "" {
+		return nil, fmt.Errorf("discovery document of %s has no jwks_uri", issuer)
+	}
+	return httpGet(ctx, discovery.JwksURI)
+}
+
+func httpGet(ctx context.Context, url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+// TokenOptions are the checks of a token besides its signature, empty options are not checked
+type TokenOptions struct {
+	Issuer   string
+	Audience string
+	// Now is the time the token must be valid at. Tokens expire an hour after they are issued, usually before
+	// the outputs of the job are released, so archived tokens are checked with the zero time which skips the check
+	Now time.Time
+}
+
+// VerifyToken checks the RS256 signature of a token with the keys of its issuer and returns its claims
+func VerifyToken(token string, keys KeySet, opts TokenOptions) (*Claims, error) {
+	parts, err := splitToken(token)
+	if err != nil {
+		return nil, err
+	}
+	content, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode attestation token header: %w", err)
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err = json.Unmarshal(content, &header); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal attestation token header: %w", err)
+	}
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("attestation token is signed with %q instead of RS256", header.Alg)
+	}
+	key, ok := keys[header.Kid]
+	if !ok {
+		return nil, fmt.Errorf("attestation token is signed with unknown key %q", header.Kid)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode attestation token signature: %w", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("invalid attestation token signature: %w", err)
+	}
+	claims, err := decodeClaims(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	if opts.Issuer != "" && claims.Issuer != opts.Issuer {
+		return nil, fmt.Errorf("attestation token is issued by %s instead of %s", claims.Issuer, opts.Issuer)
+	}
+	if opts.Audience != "" && !claims.HasAudience(opts.Audience) {
+		return nil, fmt.Errorf("attestation token is for audience %v instead of %s", claims.Audience, opts.Audience)
+	}
+	if !opts.Now.IsZero() {
+		if claims.ExpiresAt == 0 || opts.Now.Unix() >= claims.ExpiresAt {
+			return nil, fmt.Errorf("attestation token expired at %s", time.Unix(claims.ExpiresAt, 0).UTC())
+		}
+		if opts.Now.Unix() < claims.NotBefore {
+			return nil, fmt.Errorf("attestation token is not valid before %s", time.Unix(claims.NotBefore, 0).UTC())
+		}
+	}
+	return claims, nil
+}
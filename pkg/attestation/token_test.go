@@ -0,0 +1,157 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testKid      = "test-key"
+	testAudience = "https://sts.googleapis.com"
+)
+
+// testIssuer signs attestation tokens with a local key, its JWKS is the pinned bundle of the tests
+type testIssuer struct {
+	key  *rsa.PrivateKey
+	jwks []byte
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	jwks, err := json.Marshal(JWKS{Keys: []JWK{{
+		Kid: testKid,
+		Kty: "RSA",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+	if err != nil {
+		t.Fatalf("failed to marshal JWKS: %v", err)
+	}
+	return &testIssuer{key: key, jwks: jwks}
+}
+
+func (i *testIssuer) keys(t *testing.T) KeySet {
+	t.Helper()
+	keys, err := ParseJWKS(i.jwks)
+	if err != nil {
+		t.Fatalf("failed to parse pinned JWKS: %v", err)
+	}
+	return keys
+}
+
+func (i *testIssuer) sign(t *testing.T, header map[string]string, claims interface{}) string {
+	t.Helper()
+	encode := func(v interface{}) string {
+		content, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("failed to marshal token: %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(content)
+	}
+	signed := encode(header) + "." + encode(claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, i.key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// token signs a token like the ones of Confidential Space, issued at now and valid for an hour
+func (i *testIssuer) token(t *testing.T, now time.Time, imageDigest string, nonce string) string {
+	t.Helper()
+	return i.sign(t, map[string]string{"alg": "RS256", "kid": testKid, "typ": "JWT"}, map[string]interface{}{
+		"iss":       DefaultIssuer,
+		"aud":       testAudience,
+		"eat_nonce": []string{nonce},
+		"iat":       now.Unix(),
+		"nbf":       now.Unix(),
+		"exp":       now.Add(time.Hour).Unix(),
+		"hwmodel":   "GCP_AMD_SEV",
+		"submods": map[string]interface{}{
+			"container": map[string]interface{}{
+				"image_digest": imageDigest,
+				"env_override": map[string]string{"NETWORK_MODE": "private"},
+			},
+		},
+	})
+}
+
+func TestVerifyToken(t *testing.T) {
+	issuer := newTestIssuer(t)
+	keys := issuer.keys(t)
+	now := time.Unix(1700000000, 0)
+	token := issuer.token(t, now, "sha256:image", "nonce")
+	parts := strings.Split(token, ".")
+	tampered := parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"`+DefaultIssuer+`"}`)) + "." + parts[2]
+	unknownKey := issuer.sign(t, map[string]string{"alg": "RS256", "kid": "other"}, map[string]string{"iss": DefaultIssuer})
+	unsigned := issuer.sign(t, map[string]string{"alg": "none", "kid": testKid}, map[string]string{"iss": DefaultIssuer})
+
+	tests := []struct {
+		name    string
+		token   string
+		opts    TokenOptions
+		wantErr string
+	}{
+		{"valid", token, TokenOptions{Issuer: DefaultIssuer, Audience: testAudience, Now: now.Add(time.Minute)}, ""},
+		{"archived token without expiry check", token, TokenOptions{Issuer: DefaultIssuer}, ""},
+		{"tampered claims", tampered, TokenOptions{}, "invalid attestation token signature"},
+		{"unknown key", unknownKey, TokenOptions{}, "unknown key"},
+		{"not RS256", unsigned, TokenOptions{}, "instead of RS256"},
+		{"wrong issuer", token, TokenOptions{Issuer: "https://issuer.example.com"}, "is issued by"},
+		{"wrong audience", token, TokenOptions{Audience: "other"}, "for audience"},
+		{"expired", token, TokenOptions{Now: now.Add(2 * time.Hour)}, "expired"},
+		{"not yet valid", token, TokenOptions{Now: now.Add(-time.Minute)}, "not valid before"},
+		{"not a JWT", "token", TokenOptions{}, "not a JWT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := VerifyToken(tt.token, keys, tt.opts)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("failed to verify token: %v", err)
+				}
+				if claims.ImageDigest() != "sha256:image" || !claims.HasNonce("nonce") || claims.NetworkMode() != "private" {
+					t.Fatalf("unexpected claims %+v", claims)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseJWKSRejectsBundleWithoutRSAKeys(t *testing.T) {
+	if _, err := ParseJWKS([]byte(`{"keys":[{"kid":"ec","kty":"EC"}]}`)); err == nil {
+		t.Fatal("parsed a JWKS without RS256 keys")
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/manifest"
+)
+
+// Check is one step of the verification of a job
+type Check struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Detail string `json:"detail"`
+}
+
+// Verdict is the result of the verification of the outputs of a job, it is verified only if every check passed
+type Verdict struct {
+	Verified bool    `json:"verified"`
+	JobUUID  string  `json:"job_uuid"`
+	Nonce    string  `json:"nonce"`
+	Checks   []Check `json:"checks"`
+}
+
+func (v *Verdict) add(name string, err error, detail string) {
+	c := Check{Name: name, Passed: err == nil, Detail: detail}
+	if err != nil {
+		c.Detail = err.Error()
+	}
+	v.Checks = append(v.Checks, c)
+}
+
+// String is the human-readable verdict, one line per check
+func (v *Verdict) String() string {
+	var b strings.Builder
+	for _, c := range v.Checks {
+		status := "PASS"
+		if !c.Passed {
+			status = "FAIL"
+		}
+		fmt.Fprintf(&b, "%s %-16s %s\n", status, c.Name, c.Detail)
+	}
+	if v.Verified {
+		fmt.Fprintf(&b, "VERIFIED: the outputs were produced by job %s in an attested TEE\n", v.JobUUID)
+	} else {
+		fmt.Fprintf(&b, "NOT VERIFIED\n")
+	}
+	return b.String()
+}
+
+// Verifier checks the outputs of a job against its attestation token
+type Verifier struct {
+	Keys  KeySet
+	Token TokenOptions
+	// ImageDigest is the digest of the image the job is expected to run
+	ImageDigest string
+	// HardwareModel is the expected hwmodel claim, any hardware is accepted if it's empty
+	HardwareModel string
+	// NetworkMode is the expected network mode, any mode is accepted if it's empty
+	NetworkMode string
+}
+
+// Verify checks the signature and claims of the token, recomputes its nonce from the commitment and the outputs,
+// and checks each output. Outputs maps the paths of the outputs in the manifest of the job to local files.
+// With the manifest, any subset of the outputs can be verified; without it, outputs must be all the outputs
+// of the job so their root hash can be recomputed. The dataset and encrypted notebook hashes are taken from
+// the commitment, they can only be recomputed by who holds those files
+func (vf *Verifier) Verify(token string, c *Commitment, m *manifest.Manifest, outputs map[string]string) *Verdict {
+	v := &Verdict{JobUUID: c.JobUUID, Checks: []Check{}}
+	claims, err := VerifyToken(token, vf.Keys, vf.Token)
+	v.add("token", err, "signature and claims are valid")
+	if err == nil {
+		err = checkImage(vf.ImageDigest, claims.ImageDigest(), c.ImageDigest)
+		v.add("image", err, "the job ran image "+vf.ImageDigest)
+		if vf.HardwareModel != "" {
+			err = nil
+			if claims.HardwareModel != vf.HardwareModel {
+				err = fmt.Errorf("the job ran on hardware %s instead of %s", claims.HardwareModel, vf.HardwareModel)
+			}
+			v.add("hardware", err, "the job ran on hardware "+vf.HardwareModel)
+		}
+		if vf.NetworkMode != "" {
+			err = nil
+			if claims.NetworkMode() != vf.NetworkMode {
+				err = fmt.Errorf("the job ran in network mode %q instead of %s", claims.NetworkMode(), vf.NetworkMode)
+			}
+			v.add("network", err, "the job ran in network mode "+vf.NetworkMode)
+		}
+	}
+
+	artifacts := []manifest.Artifact{}
+	paths := []string{}
+	for p := range outputs {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		sum, err := manifest.HashFile(outputs[p])
+		if err == nil && m != nil {
+			err = checkArtifact(m, p, sum)
+		}
+		v.add("output "+p, err, "sha256 "+sum)
+		artifacts = append(artifacts, manifest.Artifact{Path: p, Sha256: sum})
+	}
+	root := manifest.RootHash(artifacts)
+	if m != nil {
+		root = m.Root
+	}
+	if root != c.ManifestRoot {
+		v.add("manifest", fmt.Errorf("root hash %s of the outputs is not committed to", root), "")
+	} else {
+		v.add("manifest", nil, "root hash "+root)
+	}
+
+	v.Nonce, err = c.Nonce()
+	if err == nil && claims != nil && !claims.HasNonce(v.Nonce) {
+		err = fmt.Errorf("commitment %s is not a nonce of the token", v.Nonce)
+	} else if err == nil && claims == nil {
+		err = fmt.Errorf("the token is not valid")
+	}
+	v.add("nonce", err, "the token commits to "+v.Nonce)
+
+	v.Verified = len(outputs) > 0
+	for _, check := range v.Checks {
+		v.Verified = v.Verified && check.Passed
+	}
+	return v
+}
+
+func checkImage(expected string, attested string, committed string) error {
+	if expected == "" {
+		return fmt.Errorf("no expected image digest")
+	}
+	if attested != expected {
+		return fmt.Errorf("the job ran image %s instead of %s", attested, expected)
+	}
+	if committed != expected {
+		return fmt.Errorf("the commitment is for image %s instead of %s", committed, expected)
+	}
+	return nil
+}
+
+func checkArtifact(m *manifest.Manifest, p string, sum string) error {
+	a, ok := m.Get(p)
+	if !ok {
+		return fmt.Errorf("%s is not in the manifest", p)
+	}
+	if a.Sha256 != sum {
+		return fmt.Errorf("sha256 %s doesn't match %s in the manifest", sum, a.Sha256)
+	}
+	return nil
+}
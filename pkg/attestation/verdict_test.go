@@ -0,0 +1,186 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/manifest"
+)
+
+const testImageDigest = "sha256:0123456789abcdef"
+
+// testJob is a finished job: its outputs on disk, their manifest and the commitment the token attests
+type testJob struct {
+	outputs    map[string]string
+	manifest   *manifest.Manifest
+	commitment *Commitment
+	nonce      string
+}
+
+func newTestJob(t *testing.T) *testJob {
+	t.Helper()
+	dir := t.TempDir()
+	contents := map[string][]byte{
+		"job.ipynb":           []byte(`{"cells":[]}`),
+		"outputs/summary.csv": []byte("count\n42\n"),
+	}
+	outputs := map[string]string{}
+	for p, content := range contents {
+		file := filepath.Join(dir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+			t.Fatalf("failed to create output directory: %v", err)
+		}
+		if err := os.WriteFile(file, content, 0600); err != nil {
+			t.Fatalf("failed to write output: %v", err)
+		}
+		outputs[p] = file
+	}
+	m, err := manifest.New(contents)
+	if err != nil {
+		t.Fatalf("failed to build manifest: %v", err)
+	}
+	c := &Commitment{
+		JobUUID:               "job-uuid",
+		ImageDigest:           testImageDigest,
+		Datasets:              []DatasetVersion{{Name: "sales", Sha256: "abcd"}},
+		ManifestRoot:          m.Root,
+		EncryptedOutputSha256: "ef01",
+	}
+	nonce, err := c.Nonce()
+	if err != nil {
+		t.Fatalf("failed to compute nonce: %v", err)
+	}
+	return &testJob{outputs: outputs, manifest: m, commitment: c, nonce: nonce}
+}
+
+// failedChecks returns the names of the checks of the verdict that failed
+func failedChecks(v *Verdict) []string {
+	res := []string{}
+	for _, c := range v.Checks {
+		if !c.Passed {
+			res = append(res, c.Name)
+		}
+	}
+	return res
+}
+
+func TestVerify(t *testing.T) {
+	issuer := newTestIssuer(t)
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name string
+		// change alters the job, the verifier or the token of a verified job
+		change     func(t *testing.T, j *testJob, vf *Verifier) string
+		wantFailed []string
+	}{
+		{
+			name: "verified",
+			change: func(t *testing.T, j *testJob, vf *Verifier) string {
+				return issuer.token(t, now, testImageDigest, j.nonce)
+			},
+		},
+		{
+			name: "wrong image",
+			change: func(t *testing.T, j *testJob, vf *Verifier) string {
+				return issuer.token(t, now, "sha256:other", j.nonce)
+			},
+			wantFailed: []string{"image"},
+		},
+		{
+			name: "wrong nonce",
+			change: func(t *testing.T, j *testJob, vf *Verifier) string {
+				other := *j.commitment
+				other.JobUUID = "other-job"
+				nonce, err := other.Nonce()
+				if err != nil {
+					t.Fatalf("failed to compute nonce: %v", err)
+				}
+				return issuer.token(t, now, testImageDigest, nonce)
+			},
+			wantFailed: []string{"nonce"},
+		},
+		{
+			name: "tampered output",
+			change: func(t *testing.T, j *testJob, vf *Verifier) string {
+				if err := os.WriteFile(j.outputs["outputs/summary.csv"], []byte("count\n43\n"), 0600); err != nil {
+					t.Fatalf("failed to tamper output: %v", err)
+				}
+				return issuer.token(t, now, testImageDigest, j.nonce)
+			},
+			wantFailed: []string{"output outputs/summary.csv"},
+		},
+		{
+			name: "expired token",
+			change: func(t *testing.T, j *testJob, vf *Verifier) string {
+				vf.Token.Now = now.Add(2 * time.Hour)
+				return issuer.token(t, now, testImageDigest, j.nonce)
+			},
+			wantFailed: []string{"token", "nonce"},
+		},
+		{
+			name: "wrong hardware and network mode",
+			change: func(t *testing.T, j *testJob, vf *Verifier) string {
+				vf.HardwareModel = "GCP_INTEL_TDX"
+				vf.NetworkMode = "open"
+				return issuer.token(t, now, testImageDigest, j.nonce)
+			},
+			wantFailed: []string{"hardware", "network"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := newTestJob(t)
+			vf := &Verifier{
+				Keys:          issuer.keys(t),
+				Token:         TokenOptions{Issuer: DefaultIssuer, Audience: testAudience, Now: now.Add(time.Minute)},
+				ImageDigest:   testImageDigest,
+				HardwareModel: "GCP_AMD_SEV",
+				NetworkMode:   "private",
+			}
+			token := tt.change(t, j, vf)
+			v := vf.Verify(token, j.commitment, j.manifest, j.outputs)
+			failed := failedChecks(v)
+			if v.Verified != (len(tt.wantFailed) == 0) || !slices.Equal(failed, tt.wantFailed) {
+				t.Fatalf("got verified %v with failed checks %v, want %v\n%s", v.Verified, failed, tt.wantFailed, v)
+			}
+			if v.Nonce != j.nonce {
+				t.Fatalf("got nonce %s, want %s", v.Nonce, j.nonce)
+			}
+		})
+	}
+}
+
+func TestVerifyWithoutManifest(t *testing.T) {
+	issuer := newTestIssuer(t)
+	now := time.Unix(1700000000, 0)
+	j := newTestJob(t)
+	vf := &Verifier{Keys: issuer.keys(t), ImageDigest: testImageDigest}
+	token := issuer.token(t, now, testImageDigest, j.nonce)
+	if v := vf.Verify(token, j.commitment, nil, j.outputs); !v.Verified {
+		t.Fatalf("failed to verify all the outputs without the manifest:\n%s", v)
+	}
+	// without the manifest the root hash can't be recomputed from a subset of the outputs
+	delete(j.outputs, "job.ipynb")
+	v := vf.Verify(token, j.commitment, nil, j.outputs)
+	if failed := failedChecks(v); v.Verified || !slices.Equal(failed, []string{"manifest"}) {
+		t.Fatalf("got verified %v with failed checks %v, want the manifest check to fail", v.Verified, failed)
+	}
+}
@@ -1,17 +1,15 @@
 package cloud
 
 import (
-	"bytes"
 	"compress/gzip"
 	"context"
-	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
+	"strings"
 
 	compute "cloud.google.com/go/compute/apiv1"
 	"cloud.google.com/go/compute/apiv1/computepb"
@@ -29,9 +27,12 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/signature"
 )
 
 type WorkloadIdentityPoolProvider struct {
+	Name               string            `json:"name,omitempty"`
+	State              string            `json:"state,omitempty"`
 	DisplayName        string            `json:"displayName"`
 	Description        string            `json:"description"`
 	AttributeMapping   map[string]string `json:"attributeMapping"`
@@ -134,8 +135,9 @@ func (g *GcpService) GetFilebyChunk(remotePath string, offset int64, chunkSize i
 	}
 	defer objectReader.Close()
 	data := make([]byte, chunkSize)
-	n, err := objectReader.Read(data)
-	if err != nil {
+	// a single Read may return less than the chunk
+	n, err := io.ReadFull(objectReader, data)
+	if err != nil && err != io.ErrUnexpectedEOF {
 		return nil, errors.Wrap(err, "failed to read cloud storage object")
 	}
 	data = data[:n]
@@ -178,6 +180,19 @@ func (g *GcpService) UploadFile(reader io.Reader, remotePath string, compress bo
 	return nil
 }
 
+func (g *GcpService) CopyFile(remoteSrcPath string, remoteDestPath string) error {
+	client, err := storage.NewClient(g.ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to create storage client")
+	}
+	defer client.Close()
+	bucket := client.Bucket(config.GetBucket())
+	if _, err = bucket.Object(remoteDestPath).CopierFrom(bucket.Object(remoteSrcPath)).Run(g.ctx); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to copy cloud storage object %s to %s", remoteSrcPath, remoteDestPath))
+	}
+	return nil
+}
+
 func (g *GcpService) CreateSymmetricKeys(keyId string) error {
 	client, err := kms.NewKeyManagementClient(g.ctx)
 	if err != nil {
@@ -282,6 +297,11 @@ func (g *GcpService) DecryptWithKMS(keyID, ciphertextB64 string) (string, error)
 }
 
 func (g *GcpService) GrantServiceAccountKeyRole(serviceAccountEmail string, keyId string, role string) error {
+	return g.GrantKeyRole("serviceAccount:"+serviceAccountEmail, keyId, role)
+}
+
+// GrantKeyRole binds the role on the key to the member, the member can be any IAM principal including a workload identity principal set
+func (g *GcpService) GrantKeyRole(member string, keyId string, role string) error {
 	client, err := kms.NewKeyManagementClient(g.ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to create key client")
@@ -296,7 +316,7 @@ func (g *GcpService) GrantServiceAccountKeyRole(serviceAccountEmail string, keyI
 	}
 	policy.Bindings = append(policy.Bindings, &iampb.Binding{
 		Role:    role,
-		Members: []string{"serviceAccount:" + serviceAccountEmail},
+		Members: []string{member},
 	})
 	res, err := client.SetIamPolicy(g.ctx, &iampb.SetIamPolicyRequest{
 		Resource: keyName,
@@ -305,16 +325,99 @@ func (g *GcpService) GrantServiceAccountKeyRole(serviceAccountEmail string, keyI
 	if err != nil {
 		return errors.Wrap(err, "failed set key iam policy")
 	}
-	hlog.Infof("[GCPService] Bind service account %s with role %s on key %s: %v", serviceAccountEmail, role, keyId, res)
+	hlog.Infof("[GCPService] Bind %s with role %s on key %s: %v", member, role, keyId, res)
 	return nil
 }
 
-func workloadIdentityRequestBody(name string, imageDigest string) ([]byte, error) {
+// RevokeKeyRole removes the member from the bindings of the role on the key
+func (g *GcpService) RevokeKeyRole(member string, keyId string, role string) error {
+	client, err := kms.NewKeyManagementClient(g.ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to create key client")
+	}
+	defer client.Close()
+	keyName := config.GetKeyFullName(keyId)
+	policy, err := client.GetIamPolicy(g.ctx, &iampb.GetIamPolicyRequest{
+		Resource: keyName,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get key iam policy")
+	}
+	bindings := []*iampb.Binding{}
+	for _, binding := range policy.Bindings {
+		if binding.Role == role {
+			members := []string{}
+			for _, m := range binding.Members {
+				if m != member {
+					members = append(members, m)
+				}
+			}
+			binding.Members = members
+		}
+		if len(binding.Members) > 0 {
+			bindings = append(bindings, binding)
+		}
+	}
+	policy.Bindings = bindings
+	_, err = client.SetIamPolicy(g.ctx, &iampb.SetIamPolicyRequest{
+		Resource: keyName,
+		Policy:   policy,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed set key iam policy")
+	}
+	hlog.Infof("[GCPService] Unbind %s with role %s on key %s", member, role, keyId)
+	return nil
+}
+
+// SignWithKMS signs the sha256 digest with the asymmetric key version
+func (g *GcpService) SignWithKMS(keyVersion string, digest []byte) ([]byte, error) {
+	client, err := kms.NewKeyManagementClient(g.ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create kms client")
+	}
+	defer client.Close()
+	req := &kmspb.AsymmetricSignRequest{
+		Name: keyVersion,
+		Digest: &kmspb.Digest{
+			Digest: &kmspb.Digest_Sha256{Sha256: digest},
+		},
+	}
+	resp, err := client.AsymmetricSign(g.ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to sign using key %s", keyVersion))
+	}
+	return resp.Signature, nil
+}
+
+// GetPublicKey returns the pem encoded public key of the asymmetric key version
+func (g *GcpService) GetPublicKey(keyVersion string) (string, error) {
+	client, err := kms.NewKeyManagementClient(g.ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create kms client")
+	}
+	defer client.Close()
+	resp, err := client.GetPublicKey(g.ctx, &kmspb.GetPublicKeyRequest{Name: keyVersion})
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("failed to get public key of %s", keyVersion))
+	}
+	return resp.Pem, nil
+}
+
+func workloadIdentityRequestBody(name string, imageDigest string, signingKeyId string, policyCondition string) ([]byte, error) {
 	serviceAccountEmail := config.GetCvmServiceAccountEmail()
 	attributeCondition := fmt.Sprintf("assertion.submods.container.image_digest == '%s' && '%s' in assertion.google_service_accounts && assertion.swname == 'CONFIDENTIAL_SPACE'", imageDigest, serviceAccountEmail)
+	if signingKeyId != "" {
+		// only images signed by the platform key can use the provider
+		attributeCondition += fmt.Sprintf(" && assertion.submods.container.image_signatures.exists(sig, sig.key_id == '%s' && sig.signature_algorithm == '%s')", signingKeyId, signature.Algorithm)
+	}
 	if !config.IsDebug() {
 		attributeCondition += " && 'STABLE' in assertion.submods.confidential_space.support_attributes"
 	}
+	if policyCondition != "" {
+		// the constraints of the data providers can only narrow the platform condition
+		attributeCondition += fmt.Sprintf(" && (%s)", policyCondition)
+	}
 	provider := WorkloadIdentityPoolProvider{
 		DisplayName: name,
 		OIDC: OIDC{
@@ -323,6 +426,8 @@ func workloadIdentityRequestBody(name string, imageDigest string) ([]byte, error
 		},
 		AttributeMapping: map[string]string{
 			"google.subject": "assertion.sub",
+			// dataset keys are granted to the principal set of the image digest of the job
+			"attribute.image_digest": "assertion.submods.container.image_digest",
 		},
 		AttributeCondition: attributeCondition,
 	}
@@ -359,76 +464,6 @@ func (g *GcpService) getAccessToken() (string, error) {
 	return token.AccessToken, nil
 }
 
-func (g *GcpService) CreateWorkloadIdentityPoolProvider(name string) error {
-	requestBody, err := workloadIdentityRequestBody(name, "")
-	if err != nil {
-		// err already is wrapped
-		return err
-	}
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: false,
-			MinVersion:         tls.VersionTLS12,
-		},
-	}
-	client := &http.Client{Transport: tr}
-	req, err := http.NewRequest("POST", config.GetCreateWipProviderUrl(name), bytes.NewReader(requestBody))
-	if err != nil {
-		return errors.Wrap(err, "failed to create request")
-	}
-	token, err := g.getAccessToken()
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := client.Do(req)
-	if err != nil {
-		return errors.Wrap(err, "failed to do http request")
-	}
-	defer resp.Body.Close()
-	_, err = io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrap(err, "failed to read http response")
-	}
-	return nil
-}
-
-func (g *GcpService) UpdateWorkloadIdentityPoolProvider(name string, imageDigest string) error {
-	requestBody, err := workloadIdentityRequestBody(name, imageDigest)
-	if err != nil {
-		return err
-	}
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: false,
-			MinVersion:         tls.VersionTLS12,
-		},
-	}
-	client := &http.Client{Transport: tr}
-	req, err := http.NewRequest("PATCH", config.GetUpdateWipProviderUrl(name), bytes.NewReader(requestBody))
-	if err != nil {
-		return errors.Wrap(err, "failed to create request")
-	}
-	token, err := g.getAccessToken()
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := client.Do(req)
-	if err != nil {
-		return errors.Wrap(err, "failed to do http request")
-	}
-	defer resp.Body.Close()
-	res, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrap(err, "failed to read http response")
-	}
-	hlog.Debugf("%v", string(res))
-	return nil
-}
-
 func (g *GcpService) GetServiceAccountEmail() (string, error) {
 	if metadata.OnGCE() {
 		email, err := metadata.Email("default")
@@ -440,14 +475,39 @@ func (g *GcpService) GetServiceAccountEmail() (string, error) {
 	return "", nil
 }
 
-func convertLabelToMap(labels []*computepb.Items) map[string]string {
+func convertMetadataToMap(items []*computepb.Items) map[string]string {
 	res := make(map[string]string)
-	for _, label := range labels {
-		res[*label.Key] = *label.Value
+	for _, item := range items {
+		res[item.GetKey()] = item.GetValue()
 	}
 	return res
 }
 
+// labelValue turns s into a label value, which only has lowercase letters, digits, dashes and underscores
+// and is at most 63 characters long
+func labelValue(s string) string {
+	value := []rune{}
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' || r == '_' {
+			value = append(value, r)
+		} else {
+			value = append(value, '_')
+		}
+	}
+	if len(value) > 63 {
+		value = value[:63]
+	}
+	return string(value)
+}
+
+func instanceLabels(uuid string, creator string) map[string]string {
+	return map[string]string{
+		LabelEnv:     labelValue(config.GetEnv()),
+		LabelJobUUID: labelValue(uuid),
+		LabelCreator: labelValue(creator),
+	}
+}
+
 func covertInstanceStatus(s *string) int {
 	switch *s {
 	case "RUNNING":
@@ -459,7 +519,22 @@ func covertInstanceStatus(s *string) int {
 	}
 }
 
+// ListAllInstances lists the job instances of the deployment in the candidate zones with one aggregated list
+// filtered by the labels of the instances
 func (g *GcpService) ListAllInstances() ([]*Instance, error) {
+	return g.listInstances(fmt.Sprintf(`labels.%s = "%s"`, LabelEnv, labelValue(config.GetEnv())))
+}
+
+// findInstance looks for the job instance in the candidate zones, it returns nil if there is none
+func (g *GcpService) findInstance(instanceName string) (*Instance, error) {
+	instances, err := g.listInstances(fmt.Sprintf(`(labels.%s = "%s") (name = "%s")`, LabelEnv, labelValue(config.GetEnv()), instanceName))
+	if err != nil || len(instances) == 0 {
+		return nil, err
+	}
+	return instances[0], nil
+}
+
+func (g *GcpService) listInstances(filter string) ([]*Instance, error) {
 	ctx := g.ctx
 	c, err := compute.NewInstancesRESTClient(ctx)
 	if err != nil {
@@ -467,37 +542,53 @@ func (g *GcpService) ListAllInstances() ([]*Instance, error) {
 	}
 	defer c.Close()
 
-	req := &computepb.ListInstancesRequest{
-		Zone:    config.GetZone(),
-		Project: config.GetProject(),
+	zones := map[string]bool{}
+	for _, zone := range config.GetZones() {
+		zones[zone] = true
+	}
+	req := &computepb.AggregatedListInstancesRequest{
+		Project:              config.GetProject(),
+		Filter:               proto.String(filter),
+		ReturnPartialSuccess: proto.Bool(true),
 	}
-	it := c.List(ctx, req)
+	it := c.AggregatedList(ctx, req)
 	instances := make([]*Instance, 0)
 	for {
-		resp, err := it.Next()
+		pair, err := it.Next()
 		if errors.Is(err, iterator.Done) {
 			break
 		}
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to list instances")
 		}
-		metadataInfo := resp.GetMetadata()
-		labelMap := convertLabelToMap(metadataInfo.Items)
-		instance := &Instance{
-			Name:         *resp.Name,
-			Status:       covertInstanceStatus(resp.Status),
-			UUID:         labelMap["JOB-UUID"],
-			Token:        labelMap["tee-env-USER_TOKEN"],
-			CreationTime: *resp.CreationTimestamp,
+		// the keys are zones/<zone>
+		zone := strings.TrimPrefix(pair.Key, "zones/")
+		if !zones[zone] {
+			continue
+		}
+		for _, resp := range pair.Value.GetInstances() {
+			uuid := resp.GetLabels()[LabelJobUUID]
+			if uuid == "" {
+				continue
+			}
+			metadataMap := convertMetadataToMap(resp.GetMetadata().GetItems())
+			instance := &Instance{
+				Name:         resp.GetName(),
+				Status:       covertInstanceStatus(resp.Status),
+				UUID:         uuid,
+				Token:        metadataMap["tee-env-USER_TOKEN"],
+				CreationTime: resp.GetCreationTimestamp(),
+				Spot:         resp.GetScheduling().GetProvisioningModel() == "SPOT",
+				Zone:         zone,
+			}
+			instances = append(instances, instance)
 		}
-		instances = append(instances, instance)
 	}
 	return instances, nil
 }
 
-func (g *GcpService) DeleteInstance(instanceName string) error {
+func (g *GcpService) DeleteInstance(zone string, instanceName string) error {
 	projectId := config.GetProject()
-	zone := config.GetZone()
 
 	ctx := g.ctx
 	c, err := compute.NewInstancesRESTClient(ctx)
@@ -525,10 +616,43 @@ func (g *GcpService) getTokenForStage2(string) (string, error) {
 	return "", nil
 }
 
-func (g *GcpService) CreateConfidentialSpace(instanceName string, dockerImage string, stage1Token string, uuid string) error {
+// CreateConfidentialSpace tries the candidate zones in order and moves on to the next one when a zone
+// is out of capacity for the machine type. It's idempotent, an instance that already exists in any zone
+// is not created again
+func (g *GcpService) CreateConfidentialSpace(instanceName string, dockerImage string, stage1Token string, uuid string, spec InstanceSpec) (string, error) {
 	stage2Token, err := g.getTokenForStage2(stage1Token)
 	if err != nil {
-		return err
+		return "", err
+	}
+	existing, err := g.findInstance(instanceName)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		hlog.Infof("[GcpService]instance %s already exists in zone %s", instanceName, existing.Zone)
+		return existing.Zone, nil
+	}
+	zones := config.GetZones()
+	for i, zone := range zones {
+		req, err := g.GetConfidentialSpaceInsertInstanceRequest(zone, instanceName, dockerImage, stage2Token, uuid, spec)
+		if err != nil {
+			return "", err
+		}
+		err = g.insertInstance(req, spec.TEEType)
+		if err == nil || isAlreadyExistsError(err) {
+			return zone, nil
+		}
+		if !isCapacityError(err) || i == len(zones)-1 {
+			return "", err
+		}
+		hlog.Warnf("[GcpService]zone %s has no capacity for instance %s, trying %s: %v", zone, instanceName, zones[i+1], err)
+	}
+	return "", fmt.Errorf("no candidate zone for instance %s", instanceName)
+}
+
+func (g *GcpService) insertInstance(req *computepb.InsertInstanceRequest, teeType string) error {
+	if teeType != config.TEETypeSEV {
+		return g.insertConfidentialInstance(req, teeType)
 	}
 	ctx := g.ctx
 	c, err := compute.NewInstancesRESTClient(ctx)
@@ -537,8 +661,6 @@ func (g *GcpService) CreateConfidentialSpace(instanceName string, dockerImage st
 	}
 	defer c.Close()
 
-	req := g.GetConfidentialSpaceInsertInstanceRequest(instanceName, dockerImage, stage2Token, uuid)
-
 	op, err := c.Insert(ctx, req)
 	if err != nil {
 		return errors.Wrap(err, "failed to insert instance")
@@ -546,7 +668,7 @@ func (g *GcpService) CreateConfidentialSpace(instanceName string, dockerImage st
 	if err = op.Wait(ctx); err != nil {
 		return errors.Wrap(err, "failed to wait for insert operation to complete")
 	}
-	return nil
+	return operationError(op.Proto())
 }
 
 func getLogRedirectFlag() *string {
@@ -556,15 +678,71 @@ func getLogRedirectFlag() *string {
 	return proto.String("false")
 }
 
-func (g *GcpService) GetConfidentialSpaceInsertInstanceRequest(instanceName string, dockerImage string, stage2Token string, uuid string) *computepb.InsertInstanceRequest {
+// scheduling stops preempted spot instances instead of deleting them, so the monitor can tell a preemption
+// from the end of the job
+func scheduling(spot bool) *computepb.Scheduling {
+	if !spot {
+		return &computepb.Scheduling{
+			OnHostMaintenance: proto.String("TERMINATE"),
+		}
+	}
+	return &computepb.Scheduling{
+		OnHostMaintenance:         proto.String("TERMINATE"),
+		ProvisioningModel:         proto.String("SPOT"),
+		InstanceTerminationAction: proto.String("STOP"),
+		AutomaticRestart:          proto.Bool(false),
+	}
+}
+
+// accessConfigs gives an external IP to the instances in open mode only, the others reach Google APIs
+// through Private Google Access of the subnetwork
+func accessConfigs(networkMode string) []*computepb.AccessConfig {
+	if networkMode != config.NetworkModeOpen {
+		return nil
+	}
+	return []*computepb.AccessConfig{
+		&computepb.AccessConfig{
+			Name: proto.String("external-nat"),
+			Type: proto.String("ONE_TO_ONE_NAT"),
+		},
+	}
+}
+
+// networkMetadata passes the network mode to the launcher, which reports it in the env_override claim of the
+// attestation token, and the egress proxy to the workload in proxy mode
+func networkMetadata(networkMode string) []*computepb.Items {
+	items := []*computepb.Items{&computepb.Items{
+		Key:   proto.String("tee-env-NETWORK_MODE"),
+		Value: proto.String(networkMode),
+	}}
+	if networkMode != config.NetworkModeProxy {
+		return items
+	}
+	proxy := "http://" + config.GetEgressProxy()
+	return append(items, &computepb.Items{
+		Key:   proto.String("tee-env-HTTP_PROXY"),
+		Value: proto.String(proxy),
+	}, &computepb.Items{
+		Key:   proto.String("tee-env-HTTPS_PROXY"),
+		Value: proto.String(proxy),
+	}, &computepb.Items{
+		// Google APIs and the metadata server don't go through the proxy
+		Key:   proto.String("tee-env-NO_PROXY"),
+		Value: proto.String("metadata.google.internal,169.254.169.254,.googleapis.com"),
+	})
+}
+
+func (g *GcpService) GetConfidentialSpaceInsertInstanceRequest(zone string, instanceName string, dockerImage string, stage2Token string, uuid string, spec InstanceSpec) (*computepb.InsertInstanceRequest, error) {
 	cvmServiceAccountEmail := config.GetCvmServiceAccountEmail()
-	zone := config.GetZone()
-	machineType := config.GetMachineType()
+	machineType, err := config.GetMachineType(zone, spec.TEEType, spec.Profile)
+	if err != nil {
+		return nil, err
+	}
 	network := config.GetNetwork()
-	subNetwork := config.GetSubNetwork()
+	subNetwork := config.GetSubNetwork(config.GetZoneRegion(zone))
 	imageSource := config.GetTEEImageSource()
 	logRedirectFlag := getLogRedirectFlag()
-	diskSize := config.GetCvmDiskSize()
+	diskSize := int64(spec.Profile.DiskSize)
 	instanceResource := computepb.Instance{
 		ConfidentialInstanceConfig: &computepb.ConfidentialInstanceConfig{
 			EnableConfidentialCompute: proto.Bool(true),
@@ -579,6 +757,10 @@ func (g *GcpService) GetConfidentialSpaceInsertInstanceRequest(instanceName stri
 			}, &computepb.Items{
 				Key:   proto.String("tee-image-reference"),
 				Value: &dockerImage,
+			}, &computepb.Items{
+				// the launcher reports the cosign signatures found here in the attestation token
+				Key:   proto.String("tee-signed-image-repos"),
+				Value: proto.String(imageRepository(dockerImage)),
 			}, &computepb.Items{
 				Key:   proto.String("tee-env-USER_TOKEN"),
 				Value: &stage2Token,
@@ -601,7 +783,7 @@ func (g *GcpService) GetConfidentialSpaceInsertInstanceRequest(instanceName stri
 			},
 		},
 		Tags: &computepb.Tags{
-			Items: []string{"tee-instance"},
+			Items: []string{"tee-instance", config.GetNetworkTag(spec.NetworkMode)},
 		},
 		ServiceAccounts: []*computepb.ServiceAccount{
 			&computepb.ServiceAccount{
@@ -610,6 +792,7 @@ func (g *GcpService) GetConfidentialSpaceInsertInstanceRequest(instanceName stri
 			},
 		},
 		Name:        &instanceName,
+		Labels:      instanceLabels(uuid, spec.Creator),
 		MachineType: &machineType,
 		Disks: []*computepb.AttachedDisk{
 			&computepb.AttachedDisk{
@@ -621,29 +804,23 @@ func (g *GcpService) GetConfidentialSpaceInsertInstanceRequest(instanceName stri
 				},
 			},
 		},
-		Scheduling: &computepb.Scheduling{
-			OnHostMaintenance: proto.String("TERMINATE"),
-		},
+		Scheduling: scheduling(spec.Spot),
 		NetworkInterfaces: []*computepb.NetworkInterface{
 			&computepb.NetworkInterface{
-				AccessConfigs: []*computepb.AccessConfig{
-					&computepb.AccessConfig{
-						Name: proto.String("external-nat"),
-						Type: proto.String("ONE_TO_ONE_NAT"),
-					},
-				},
-				Network:    &network,
-				Subnetwork: &subNetwork,
+				AccessConfigs: accessConfigs(spec.NetworkMode),
+				Network:       &network,
+				Subnetwork:    &subNetwork,
 			},
 		},
 		CanIpForward: proto.Bool(false),
 	}
+	instanceResource.Metadata.Items = append(instanceResource.Metadata.Items, networkMetadata(spec.NetworkMode)...)
 	req := &computepb.InsertInstanceRequest{
 		InstanceResource: &instanceResource,
 		Zone:             zone,
 		Project:          config.GetProject(),
 	}
-	return req
+	return req, nil
 }
 
 func (g *GcpService) PrepareResourcesForUser(user string) error {
@@ -668,12 +845,6 @@ func (g *GcpService) PrepareResourcesForUser(user string) error {
 			return err
 		}
 	}
-	// create the workload identity pool provider for the user. The image digest set empty
-	wipProvider := config.GetUserWipProvider(user)
-	err = g.CreateWorkloadIdentityPoolProvider(wipProvider)
-	if err != nil {
-		return err
-	}
-
+	// the workload identity pool provider is created per job when the job runs
 	return nil
 }
@@ -0,0 +1,162 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/pkg/errors"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+)
+
+const computeApiUrl = "https://compute.googleapis.com/compute/v1"
+
+// insertConfidentialInstance inserts the instance with its confidential instance type through the REST API.
+// The compute client only has EnableConfidentialCompute, which is SEV, while SEV-SNP and TDX instances
+// need confidentialInstanceType
+func (g *GcpService) insertConfidentialInstance(req *computepb.InsertInstanceRequest, teeType string) error {
+	content, err := protojson.Marshal(req.InstanceResource)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal instance")
+	}
+	instance := map[string]interface{}{}
+	if err = json.Unmarshal(content, &instance); err != nil {
+		return errors.Wrap(err, "failed to unmarshal instance")
+	}
+	instance["confidentialInstanceConfig"] = map[string]string{"confidentialInstanceType": teeType}
+	body, err := json.Marshal(instance)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal instance")
+	}
+	url := fmt.Sprintf("%s/projects/%s/zones/%s/instances", computeApiUrl, req.Project, req.Zone)
+	httpReq, err := http.NewRequestWithContext(g.ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	token, err := g.getAccessToken()
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to insert instance")
+	}
+	defer resp.Body.Close()
+	res, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to insert instance, status %d: %s", resp.StatusCode, string(res))
+	}
+	op := &computepb.Operation{}
+	if err = protojson.Unmarshal(res, op); err != nil {
+		return errors.Wrap(err, "failed to unmarshal insert operation")
+	}
+	return g.waitZoneOperation(req.Zone, op)
+}
+
+// waitZoneOperation waits until the operation is done, Wait returns after two minutes at most
+func (g *GcpService) waitZoneOperation(zone string, op *computepb.Operation) error {
+	c, err := compute.NewZoneOperationsRESTClient(g.ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to create gcp zone operations rest client")
+	}
+	defer c.Close()
+	for op.GetStatus() != computepb.Operation_DONE {
+		op, err = c.Wait(g.ctx, &computepb.WaitZoneOperationRequest{
+			Operation: op.GetName(),
+			Project:   config.GetProject(),
+			Zone:      zone,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to wait for insert operation to complete")
+		}
+	}
+	return operationError(op)
+}
+
+// operationError returns the first error of a done operation, with its code so capacity errors can be told apart
+func operationError(op *computepb.Operation) error {
+	if op.GetError() != nil && len(op.GetError().GetErrors()) > 0 {
+		e := op.GetError().GetErrors()[0]
+		return fmt.Errorf("insert operation failed: %s: %s", e.GetCode(), e.GetMessage())
+	}
+	return nil
+}
+
+// capacityErrors are the errors of an insertion into a zone that can't host the machine type right now, with or without details,
+// another zone may still have capacity
+var capacityErrors = []string{
+	"ZONE_RESOURCE_POOL_EXHAUSTED",
+	"QUOTA_EXCEEDED",
+	"does not have enough resources",
+	"is not available in zone",
+}
+
+// isAlreadyExistsError reports whether the insertion failed because the instance was created by an earlier
+// insertion in the same zone
+func isAlreadyExistsError(err error) bool {
+	return strings.Contains(err.Error(), "alreadyExists") || strings.Contains(err.Error(), "already exists")
+}
+
+func isCapacityError(err error) bool {
+	for _, e := range capacityErrors {
+		if strings.Contains(err.Error(), e) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInstancePreempted looks for the preemption operation of the instance, compute engine keeps the operations
+// of a zone for several days
+func (g *GcpService) IsInstancePreempted(zone string, instanceName string) (bool, error) {
+	c, err := compute.NewZoneOperationsRESTClient(g.ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create gcp zone operations rest client")
+	}
+	defer c.Close()
+	it := c.List(g.ctx, &computepb.ListZoneOperationsRequest{
+		Project: config.GetProject(),
+		Zone:    zone,
+		Filter:  proto.String(`operationType="compute.instances.preempted"`),
+	})
+	for {
+		op, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.Wrap(err, "failed to list zone operations")
+		}
+		if strings.HasSuffix(op.GetTargetLink(), "/instances/"+instanceName) {
+			return true, nil
+		}
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
+)
+
+const (
+	wipProviderStateActive = "ACTIVE"
+	iamPollInterval        = 2 * time.Second
+	iamPollTimeout         = 2 * time.Minute
+)
+
+// codes of google.rpc.Code, a failed operation carries one of them instead of an HTTP status
+const (
+	rpcCodeNotFound         = 5
+	rpcCodeAlreadyExists    = 6
+	rpcCodePermissionDenied = 7
+)
+
+var (
+	ErrAlreadyExists    = stderrors.New("already exists")
+	ErrNotFound         = stderrors.New("not found")
+	ErrPermissionDenied = stderrors.New("permission denied")
+)
+
+// IAMError is returned when the IAM API answers with an error status or a failed operation
+type IAMError struct {
+	Op string
+	// StatusCode is the HTTP status of a failed request, it's 0 for a failed operation
+	StatusCode int
+	// Code is the google.rpc code of a failed operation
+	Code    int
+	Message string
+}
+
+func (e *IAMError) Error() string {
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("iam %s failed with code %d: %s", e.Op, e.Code, e.Message)
+	}
+	return fmt.Sprintf("iam %s failed with status %d: %s", e.Op, e.StatusCode, e.Message)
+}
+
+// Unwrap maps the status or the rpc code to the sentinel errors, so callers can use errors.Is
+func (e *IAMError) Unwrap() error {
+	if e.StatusCode == 0 {
+		switch e.Code {
+		case rpcCodeAlreadyExists:
+			return ErrAlreadyExists
+		case rpcCodeNotFound:
+			return ErrNotFound
+		case rpcCodePermissionDenied:
+			return ErrPermissionDenied
+		default:
+			return nil
+		}
+	}
+	switch e.StatusCode {
+	case http.StatusConflict:
+		return ErrAlreadyExists
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusForbidden:
+		return ErrPermissionDenied
+	default:
+		return nil
+	}
+}
+
+type iamStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type iamErrorResponse struct {
+	Error iamStatus `json:"error"`
+}
+
+type iamOperation struct {
+	Name  string     `json:"name"`
+	Done  bool       `json:"done"`
+	Error *iamStatus `json:"error,omitempty"`
+}
+
+// IAMClient talks to the IAM REST API for workload identity pool providers
+type IAMClient struct {
+	baseUrl      string
+	httpClient   *http.Client
+	getToken     func() (string, error)
+	pollInterval time.Duration
+	pollTimeout  time.Duration
+}
+
+// NewIAMClient creates an IAM client, baseUrl is the API root such as https://iam.googleapis.com/v1
+func NewIAMClient(baseUrl string, httpClient *http.Client, getToken func() (string, error)) *IAMClient {
+	return &IAMClient{
+		baseUrl:      baseUrl,
+		httpClient:   httpClient,
+		getToken:     getToken,
+		pollInterval: iamPollInterval,
+		pollTimeout:  iamPollTimeout,
+	}
+}
+
+func (g *GcpService) iamClient() *IAMClient {
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: false,
+			MinVersion:         tls.VersionTLS12,
+		},
+	}
+	return NewIAMClient(config.GetIamApiUrl(), &http.Client{Transport: tr}, g.getAccessToken)
+}
+
+// CreateWorkloadIdentityPoolProvider creates the provider and waits until it's active, it's updated if it already exists
+func (g *GcpService) CreateWorkloadIdentityPoolProvider(name string, imageDigest string, signingKeyId string, policyCondition string) error {
+	requestBody, err := workloadIdentityRequestBody(name, imageDigest, signingKeyId, policyCondition)
+	if err != nil {
+		// err already is wrapped
+		return err
+	}
+	err = g.iamClient().CreateWorkloadIdentityPoolProvider(config.GetWipPoolPath(), name, requestBody)
+	if stderrors.Is(err, ErrAlreadyExists) {
+		hlog.Infof("[GCPService] workload identity pool provider %s already exists, update it", name)
+		return g.UpdateWorkloadIdentityPoolProvider(name, imageDigest, signingKeyId, policyCondition)
+	}
+	return err
+}
+
+// UpdateWorkloadIdentityPoolProvider replaces the attribute condition of the provider and waits until it's active
+func (g *GcpService) UpdateWorkloadIdentityPoolProvider(name string, imageDigest string, signingKeyId string, policyCondition string) error {
+	requestBody, err := workloadIdentityRequestBody(name, imageDigest, signingKeyId, policyCondition)
+	if err != nil {
+		return err
+	}
+	return g.iamClient().UpdateWorkloadIdentityPoolProvider(fmt.Sprintf("%s/providers/%s", config.GetWipPoolPath(), name), requestBody)
+}
+
+// DeleteWorkloadIdentityPoolProvider deletes the provider, a missing provider is not an error
+func (g *GcpService) DeleteWorkloadIdentityPoolProvider(name string) error {
+	err := g.iamClient().DeleteWorkloadIdentityPoolProvider(fmt.Sprintf("%s/providers/%s", config.GetWipPoolPath(), name))
+	if stderrors.Is(err, ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (c *IAMClient) CreateWorkloadIdentityPoolProvider(poolPath string, providerId string, body []byte) error {
+	url := fmt.Sprintf("%s/%s/providers?workloadIdentityPoolProviderId=%s", c.baseUrl, poolPath, providerId)
+	op, err := c.doOperation("create provider", "POST", url, body)
+	if err != nil {
+		return err
+	}
+	if err = c.waitOperation(op); err != nil {
+		return err
+	}
+	return c.waitProviderActive(fmt.Sprintf("%s/providers/%s", poolPath, providerId))
+}
+
+func (c *IAMClient) UpdateWorkloadIdentityPoolProvider(providerPath string, body []byte) error {
+	url := fmt.Sprintf("%s/%s?updateMask=attributeCondition", c.baseUrl, providerPath)
+	op, err := c.doOperation("update provider", "PATCH", url, body)
+	if err != nil {
+		return err
+	}
+	if err = c.waitOperation(op); err != nil {
+		return err
+	}
+	return c.waitProviderActive(providerPath)
+}
+
+func (c *IAMClient) DeleteWorkloadIdentityPoolProvider(providerPath string) error {
+	op, err := c.doOperation("delete provider", "DELETE", fmt.Sprintf("%s/%s", c.baseUrl, providerPath), nil)
+	if err != nil {
+		return err
+	}
+	return c.waitOperation(op)
+}
+
+func (c *IAMClient) GetWorkloadIdentityPoolProvider(providerPath string) (*WorkloadIdentityPoolProvider, error) {
+	res, err := c.do("get provider", "GET", fmt.Sprintf("%s/%s", c.baseUrl, providerPath), nil)
+	if err != nil {
+		return nil, err
+	}
+	provider := &WorkloadIdentityPoolProvider{}
+	if err = json.Unmarshal(res, provider); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal workload identity pool provider")
+	}
+	return provider, nil
+}
+
+// waitOperation polls the long-running operation until it's done
+func (c *IAMClient) waitOperation(op *iamOperation) error {
+	deadline := time.Now().Add(c.pollTimeout)
+	for !op.Done {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for iam operation %s", op.Name)
+		}
+		time.Sleep(c.pollInterval)
+		var err error
+		op, err = c.doOperation("get operation", "GET", fmt.Sprintf("%s/%s", c.baseUrl, op.Name), nil)
+		if err != nil {
+			return err
+		}
+	}
+	if op.Error != nil {
+		return &IAMError{Op: "operation " + op.Name, Code: op.Error.Code, Message: op.Error.Message}
+	}
+	return nil
+}
+
+// waitProviderActive polls the provider until its state is ACTIVE
+func (c *IAMClient) waitProviderActive(providerPath string) error {
+	deadline := time.Now().Add(c.pollTimeout)
+	for {
+		provider, err := c.GetWorkloadIdentityPoolProvider(providerPath)
+		if err != nil {
+			return err
+		}
+		if provider.State == wipProviderStateActive {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for provider %s to be active, state: %s", providerPath, provider.State)
+		}
+		time.Sleep(c.pollInterval)
+	}
+}
+
+func (c *IAMClient) doOperation(op string, method string, url string, body []byte) (*iamOperation, error) {
+	res, err := c.do(op, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	operation := &iamOperation{}
+	if err = json.Unmarshal(res, operation); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal iam operation")
+	}
+	return operation, nil
+}
+
+func (c *IAMClient) do(op string, method string, url string, body []byte) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	token, err := c.getToken()
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to do http request")
+	}
+	defer resp.Body.Close()
+	res, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read http response")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		iamErr := &IAMError{Op: op, StatusCode: resp.StatusCode, Message: string(res)}
+		var errResp iamErrorResponse
+		if json.Unmarshal(res, &errResp) == nil && errResp.Error.Message != "" {
+			iamErr.Message = errResp.Error.Message
+		}
+		return nil, iamErr
+	}
+	return res, nil
+}
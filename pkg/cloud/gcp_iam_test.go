@@ -0,0 +1,197 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testPoolPath = "projects/p/locations/global/workloadIdentityPools/pool"
+
+// iamStandIn answers the IAM requests of the tests with canned responses and records the requests
+type iamStandIn struct {
+	mu       sync.Mutex
+	requests []string
+	handle   func(w http.ResponseWriter, r *http.Request)
+}
+
+func (s *iamStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	s.mu.Unlock()
+	if r.Header.Get("Authorization") != "Bearer test-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	s.handle(w, r)
+}
+
+func (s *iamStandIn) count(prefix string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, r := range s.requests {
+		if strings.HasPrefix(r, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+func newTestIAMClient(t *testing.T, standIn *iamStandIn) *IAMClient {
+	server := httptest.NewServer(standIn)
+	t.Cleanup(server.Close)
+	c := NewIAMClient(server.URL, server.Client(), func() (string, error) { return "test-token", nil })
+	c.pollInterval = time.Millisecond
+	c.pollTimeout = time.Second
+	return c
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Errorf("failed to write response: %v", err)
+	}
+}
+
+func TestCreateProviderErrorStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   error
+	}{
+		{"conflict", http.StatusConflict, ErrAlreadyExists},
+		{"forbidden", http.StatusForbidden, ErrPermissionDenied},
+		{"not found", http.StatusNotFound, ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standIn := &iamStandIn{handle: func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(t, w, tt.status, iamErrorResponse{Error: iamStatus{Code: tt.status, Message: tt.name}})
+			}}
+			err := newTestIAMClient(t, standIn).CreateWorkloadIdentityPoolProvider(testPoolPath, "job", []byte("{}"))
+			if !stderrors.Is(err, tt.want) {
+				t.Fatalf("got error %v, want %v", err, tt.want)
+			}
+			var iamErr *IAMError
+			if !stderrors.As(err, &iamErr) || iamErr.StatusCode != tt.status || iamErr.Message != tt.name {
+				t.Fatalf("got error %#v, want status %d and message %s", err, tt.status, tt.name)
+			}
+			if n := standIn.count("GET "); n != 0 {
+				t.Fatalf("got %d polls after a failed request, want 0", n)
+			}
+		})
+	}
+}
+
+func TestCreateProviderWaitsForOperationAndActiveState(t *testing.T) {
+	providerPath := "/" + testPoolPath + "/providers/job"
+	standIn := &iamStandIn{}
+	standIn.handle = func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/"+testPoolPath+"/providers":
+			if got := r.URL.Query().Get("workloadIdentityPoolProviderId"); got != "job" {
+				t.Errorf("got provider id %s, want job", got)
+			}
+			writeJSON(t, w, http.StatusOK, iamOperation{Name: "operations/create"})
+		case r.Method == http.MethodGet && r.URL.Path == "/operations/create":
+			// the operation completes on the third poll
+			writeJSON(t, w, http.StatusOK, iamOperation{Name: "operations/create", Done: standIn.count("GET /operations/") >= 3})
+		case r.Method == http.MethodGet && r.URL.Path == providerPath:
+			state := "STATE_UNSPECIFIED"
+			if standIn.count("GET "+providerPath) >= 2 {
+				state = wipProviderStateActive
+			}
+			writeJSON(t, w, http.StatusOK, WorkloadIdentityPoolProvider{Name: "job", State: state})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}
+	err := newTestIAMClient(t, standIn).CreateWorkloadIdentityPoolProvider(testPoolPath, "job", []byte("{}"))
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+	if n := standIn.count("GET /operations/"); n != 3 {
+		t.Fatalf("got %d operation polls, want 3", n)
+	}
+	if n := standIn.count("GET " + providerPath); n != 2 {
+		t.Fatalf("got %d provider polls, want 2", n)
+	}
+}
+
+func TestOperationErrorMapsRpcCode(t *testing.T) {
+	tests := []struct {
+		name string
+		code int
+		want error
+	}{
+		{"already exists", rpcCodeAlreadyExists, ErrAlreadyExists},
+		{"not found", rpcCodeNotFound, ErrNotFound},
+		{"permission denied", rpcCodePermissionDenied, ErrPermissionDenied},
+		{"failed precondition", 9, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standIn := &iamStandIn{handle: func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(t, w, http.StatusOK, iamOperation{Name: "operations/update", Done: true, Error: &iamStatus{Code: tt.code, Message: tt.name}})
+			}}
+			err := newTestIAMClient(t, standIn).UpdateWorkloadIdentityPoolProvider(testPoolPath+"/providers/job", []byte("{}"))
+			var iamErr *IAMError
+			if !stderrors.As(err, &iamErr) || iamErr.Code != tt.code || iamErr.StatusCode != 0 {
+				t.Fatalf("got error %#v, want an operation error with code %d", err, tt.code)
+			}
+			for _, sentinel := range []error{ErrAlreadyExists, ErrNotFound, ErrPermissionDenied} {
+				if got := stderrors.Is(err, sentinel); got != (sentinel == tt.want) {
+					t.Fatalf("errors.Is(%v, %v) = %v", err, sentinel, got)
+				}
+			}
+		})
+	}
+}
+
+func TestWaitProviderActiveTimeout(t *testing.T) {
+	standIn := &iamStandIn{handle: func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			writeJSON(t, w, http.StatusOK, iamOperation{Name: "operations/update", Done: true})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, WorkloadIdentityPoolProvider{Name: "job", State: "DELETED"})
+	}}
+	c := newTestIAMClient(t, standIn)
+	c.pollTimeout = 20 * time.Millisecond
+	err := c.UpdateWorkloadIdentityPoolProvider(testPoolPath+"/providers/job", []byte("{}"))
+	if err == nil || !strings.Contains(err.Error(), "timeout waiting for provider") {
+		t.Fatalf("got error %v, want a timeout", err)
+	}
+}
+
+func TestDeleteProviderNotFound(t *testing.T) {
+	standIn := &iamStandIn{handle: func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusNotFound, iamErrorResponse{Error: iamStatus{Code: http.StatusNotFound, Message: "missing"}})
+	}}
+	err := newTestIAMClient(t, standIn).DeleteWorkloadIdentityPoolProvider(testPoolPath + "/providers/job")
+	if !stderrors.Is(err, ErrNotFound) {
+		t.Fatalf("got error %v, want %v", err, ErrNotFound)
+	}
+}
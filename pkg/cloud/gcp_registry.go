@@ -0,0 +1,339 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/signature"
+)
+
+const (
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	ociEmptyMediaType    = "application/vnd.oci.empty.v1+json"
+	ociConfigMediaType   = "application/vnd.oci.image.config.v1+json"
+)
+
+var manifestMediaTypes = []string{
+	ociManifestMediaType,
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+}
+
+type ociDescriptor struct {
+	MediaType    string            `json:"mediaType"`
+	ArtifactType string            `json:"artifactType,omitempty"`
+	Digest       string            `json:"digest"`
+	Size         int64             `json:"size"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+}
+
+type ociManifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        ociDescriptor     `json:"config"`
+	Layers        []ociDescriptor   `json:"layers"`
+	Subject       *ociDescriptor    `json:"subject,omitempty"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// GetImageDigest queries the registry for the manifest digest of the image
+func (g *GcpService) GetImageDigest(image string) (string, error) {
+	host, repository, reference, err := parseImageReference(image)
+	if err != nil {
+		return "", err
+	}
+	descriptor, err := g.getManifestDescriptor(host, repository, reference)
+	if err != nil {
+		return "", err
+	}
+	return descriptor.Digest, nil
+}
+
+// AttachArtifact pushes the content as an OCI artifact referring to the image manifest with imageDigest
+func (g *GcpService) AttachArtifact(image string, imageDigest string, artifactType string, content []byte) error {
+	host, repository, _, err := parseImageReference(image)
+	if err != nil {
+		return err
+	}
+	subject, err := g.getManifestDescriptor(host, repository, imageDigest)
+	if err != nil {
+		return err
+	}
+	emptyConfig := []byte("{}")
+	configDescriptor, err := g.uploadBlob(host, repository, ociEmptyMediaType, emptyConfig)
+	if err != nil {
+		return err
+	}
+	layerDescriptor, err := g.uploadBlob(host, repository, artifactType, content)
+	if err != nil {
+		return err
+	}
+	manifest := ociManifest{
+		SchemaVersion: 2,
+		MediaType:     ociManifestMediaType,
+		ArtifactType:  artifactType,
+		Config:        configDescriptor,
+		Layers:        []ociDescriptor{layerDescriptor},
+		Subject:       &subject,
+		Annotations: map[string]string{
+			"org.opencontainers.image.created": time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal artifact manifest")
+	}
+	manifestUrl := fmt.Sprintf("https://%s/v2/%s/manifests/%s", host, repository, sha256Digest(manifestBytes))
+	resp, err := g.doRegistryRequest("PUT", manifestUrl, manifestBytes, map[string]string{"Content-Type": ociManifestMediaType})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to push artifact manifest, status: %s, response: %s", resp.Status, string(body))
+	}
+	return nil
+}
+
+// PushImageSignature stores a cosign signature of the image in the sha256-<digest>.sig tag of its repository
+func (g *GcpService) PushImageSignature(image string, imageDigest string, payload []byte, signatureB64 string) error {
+	host, repository, _, err := parseImageReference(image)
+	if err != nil {
+		return err
+	}
+	layerDescriptor, err := g.uploadBlob(host, repository, signature.PayloadMediaType, payload)
+	if err != nil {
+		return err
+	}
+	layerDescriptor.Annotations = map[string]string{
+		signature.SignatureAnnotation: signatureB64,
+	}
+	configBytes, err := json.Marshal(map[string]interface{}{
+		"architecture": "",
+		"os":           "",
+		"config":       map[string]interface{}{},
+		"rootfs": map[string]interface{}{
+			"type":     "layers",
+			"diff_ids": []string{layerDescriptor.Digest},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal signature config")
+	}
+	configDescriptor, err := g.uploadBlob(host, repository, ociConfigMediaType, configBytes)
+	if err != nil {
+		return err
+	}
+	manifest := ociManifest{
+		SchemaVersion: 2,
+		MediaType:     ociManifestMediaType,
+		Config:        configDescriptor,
+		Layers:        []ociDescriptor{layerDescriptor},
+	}
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal signature manifest")
+	}
+	manifestUrl := fmt.Sprintf("https://%s/v2/%s/manifests/%s", host, repository, signature.Tag(imageDigest))
+	resp, err := g.doRegistryRequest("PUT", manifestUrl, manifestBytes, map[string]string{"Content-Type": ociManifestMediaType})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to push signature manifest, status: %s, response: %s", resp.Status, string(body))
+	}
+	return nil
+}
+
+// GetImageSignatures returns the cosign payloads and base64 signatures stored for the image digest
+func (g *GcpService) GetImageSignatures(image string, imageDigest string) ([]ImageSignature, error) {
+	host, repository, _, err := parseImageReference(image)
+	if err != nil {
+		return nil, err
+	}
+	manifestUrl := fmt.Sprintf("https://%s/v2/%s/manifests/%s", host, repository, signature.Tag(imageDigest))
+	manifestBytes, err := g.getRegistryContent(manifestUrl, ociManifestMediaType)
+	if err != nil {
+		return nil, err
+	}
+	var manifest ociManifest
+	if err = json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal signature manifest")
+	}
+	var signatures []ImageSignature
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != signature.PayloadMediaType {
+			continue
+		}
+		payload, err := g.getRegistryContent(fmt.Sprintf("https://%s/v2/%s/blobs/%s", host, repository, layer.Digest), "")
+		if err != nil {
+			return nil, err
+		}
+		if sha256Digest(payload) != layer.Digest {
+			return nil, fmt.Errorf("digest of signature payload doesn't match %s", layer.Digest)
+		}
+		signatures = append(signatures, ImageSignature{
+			Payload:   payload,
+			Signature: layer.Annotations[signature.SignatureAnnotation],
+		})
+	}
+	return signatures, nil
+}
+
+func (g *GcpService) getRegistryContent(requestUrl string, accept string) ([]byte, error) {
+	headers := map[string]string{}
+	if accept != "" {
+		headers["Accept"] = accept
+	}
+	resp, err := g.doRegistryRequest("GET", requestUrl, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s, status: %s", requestUrl, resp.Status)
+	}
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read http response")
+	}
+	return content, nil
+}
+
+func (g *GcpService) getManifestDescriptor(host string, repository string, reference string) (ociDescriptor, error) {
+	manifestUrl := fmt.Sprintf("https://%s/v2/%s/manifests/%s", host, repository, reference)
+	resp, err := g.doRegistryRequest("HEAD", manifestUrl, nil, map[string]string{"Accept": strings.Join(manifestMediaTypes, ",")})
+	if err != nil {
+		return ociDescriptor{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return ociDescriptor{}, fmt.Errorf("failed to get manifest of %s/%s:%s, status: %s", host, repository, reference, resp.Status)
+	}
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		return ociDescriptor{}, fmt.Errorf("registry returned no digest for %s/%s:%s", host, repository, reference)
+	}
+	return ociDescriptor{
+		MediaType: resp.Header.Get("Content-Type"),
+		Digest:    digest,
+		Size:      resp.ContentLength,
+	}, nil
+}
+
+// uploadBlob pushes the content with a monolithic upload
+func (g *GcpService) uploadBlob(host string, repository string, mediaType string, content []byte) (ociDescriptor, error) {
+	digest := sha256Digest(content)
+	uploadUrl := fmt.Sprintf("https://%s/v2/%s/blobs/uploads/", host, repository)
+	resp, err := g.doRegistryRequest("POST", uploadUrl, nil, nil)
+	if err != nil {
+		return ociDescriptor{}, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		return ociDescriptor{}, fmt.Errorf("failed to start blob upload, status: %s", resp.Status)
+	}
+	location, err := resp.Location()
+	if err != nil {
+		return ociDescriptor{}, errors.Wrap(err, "failed to get blob upload location")
+	}
+	query := location.Query()
+	query.Set("digest", digest)
+	location.RawQuery = query.Encode()
+	resp, err = g.doRegistryRequest("PUT", location.String(), content, map[string]string{"Content-Type": "application/octet-stream"})
+	if err != nil {
+		return ociDescriptor{}, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return ociDescriptor{}, fmt.Errorf("failed to upload blob, status: %s", resp.Status)
+	}
+	return ociDescriptor{MediaType: mediaType, Digest: digest, Size: int64(len(content))}, nil
+}
+
+func (g *GcpService) doRegistryRequest(method string, requestUrl string, body []byte, headers map[string]string) (*http.Response, error) {
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: false,
+			MinVersion:         tls.VersionTLS12,
+		},
+	}
+	client := &http.Client{Transport: tr}
+	req, err := http.NewRequest(method, requestUrl, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	token, err := g.getAccessToken()
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to do http request")
+	}
+	return resp, nil
+}
+
+// parseImageReference splits an image like host/path/name:tag into its registry parts
+func parseImageReference(image string) (string, string, string, error) {
+	host, name, found := strings.Cut(image, "/")
+	if !found || name == "" {
+		return "", "", "", fmt.Errorf("invalid image reference %s", image)
+	}
+	if repository, digest, found := strings.Cut(name, "@"); found {
+		return host, repository, digest, nil
+	}
+	reference := "latest"
+	if i := strings.LastIndex(name, ":"); i != -1 {
+		reference = name[i+1:]
+		name = name[:i]
+	}
+	return host, name, reference, nil
+}
+
+// imageRepository strips the tag or digest of the image
+func imageRepository(image string) string {
+	host, repository, _, err := parseImageReference(image)
+	if err != nil {
+		return image
+	}
+	return fmt.Sprintf("%s/%s", host, repository)
+}
+
+func sha256Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
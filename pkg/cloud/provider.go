@@ -17,6 +17,8 @@ package cloud
 import (
 	"context"
 	"io"
+
+	"github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/pkg/config"
 )
 
 const (
@@ -25,12 +27,26 @@ const (
 	INSTANCE_OTHER      = 3
 )
 
+// labels of the confidential VMs, instances are listed by the deployment label and the job label
+const (
+	LabelEnv     = "dcr-env"
+	LabelJobUUID = "dcr-job-uuid"
+	LabelCreator = "dcr-creator"
+)
+
 type Instance struct {
 	UUID         string
 	Name         string
 	Token        string // Token is reserved for later user authentication
 	Status       int
 	CreationTime string
+	Spot         bool
+	Zone         string
+}
+
+type ImageSignature struct {
+	Payload   []byte
+	Signature string // base64 encoded
 }
 
 type CloudProvider interface {
@@ -41,25 +57,50 @@ type CloudProvider interface {
 	GetFilebyChunk(remotePath string, offset int64, chunkSize int64) ([]byte, error)
 	DeleteFile(remotePath string) error
 	UploadFile(fileReader io.Reader, remotePath string, compress bool) error
+	CopyFile(remoteSrcPath string, remoteDestPath string) error
 	// KMS
 	CreateSymmetricKeys(keyId string) error
 	CheckIfKeyExists(keyId string) (bool, error)
 	EncryptWithKMS(keyId string, plaintext string) (string, error)
 	DecryptWithKMS(keyId string, ciphertextB64 string) (string, error)
 	GrantServiceAccountKeyRole(serviceAccount string, keyId string, role string) error
+	GrantKeyRole(member string, keyId string, role string) error
+	RevokeKeyRole(member string, keyId string, role string) error
+	SignWithKMS(keyVersion string, digest []byte) ([]byte, error)
+	GetPublicKey(keyVersion string) (string, error)
 	// workload identity pool
-	CreateWorkloadIdentityPoolProvider(wipName string) error
-	UpdateWorkloadIdentityPoolProvider(wipName string, imageDigest string) error
+	CreateWorkloadIdentityPoolProvider(wipName string, imageDigest string, signingKeyId string, policyCondition string) error
+	UpdateWorkloadIdentityPoolProvider(wipName string, imageDigest string, signingKeyId string, policyCondition string) error
+	DeleteWorkloadIdentityPoolProvider(wipName string) error
+	// artifact registry
+	GetImageDigest(image string) (string, error)
+	AttachArtifact(image string, imageDigest string, artifactType string, content []byte) error
+	PushImageSignature(image string, imageDigest string, payload []byte, signatureB64 string) error
+	GetImageSignatures(image string, imageDigest string) ([]ImageSignature, error)
 	// compute engine
 	GetServiceAccountEmail() (string, error)
 	// instance
 	ListAllInstances() ([]*Instance, error)
-	DeleteInstance(instanceName string) error
-	// confidential space
-	CreateConfidentialSpace(instanceName string, dockerImage string, stage1Token string, uuid string) error
+	DeleteInstance(zone string, instanceName string) error
+	// IsInstancePreempted reports whether compute engine preempted the spot instance
+	IsInstancePreempted(zone string, instanceName string) (bool, error)
+	// confidential space, created in the first candidate zone with capacity, which is returned
+	CreateConfidentialSpace(instanceName string, dockerImage string, stage1Token string, uuid string, spec InstanceSpec) (string, error)
 	PrepareResourcesForUser(userName string) error
 }
 
+// InstanceSpec is the hardware, network and owner of a confidential space instance
+type InstanceSpec struct {
+	// Creator is the user who submitted the job, it's only informational in the labels of the instance
+	Creator string
+	TEEType string
+	Profile config.MachineProfile
+	// Spot instances are cheaper but can be preempted
+	Spot bool
+	// NetworkMode is the egress of the instance, only open instances get an external IP
+	NetworkMode string
+}
+
 func GetCloudProvider(ctx context.Context) CloudProvider {
 	return NewGcpService(ctx)
 }
@@ -16,6 +16,8 @@ package config
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/pkg/errors"
@@ -27,6 +29,11 @@ import (
 type Config struct {
 	CloudProvider CloudProvider `yaml:"CloudProvider"`
 	Cluster       Cluster       `yaml:"Cluster"`
+	Builder       Builder       `yaml:"Builder"`
+	Egress        Egress        `yaml:"Egress"`
+	Attestation   Attestation   `yaml:"Attestation"`
+	Machines      Machines      `yaml:"Machines"`
+	Launch        Launch        `yaml:"Launch"`
 }
 
 type CloudProvider struct {
@@ -37,20 +44,123 @@ type Cluster struct {
 	PodServiceAccount string `yaml:"PodServiceAccount"`
 }
 
+type Builder struct {
+	// Type is one of kaniko, buildkit and docker
+	Type string `yaml:"Type"`
+	// images must be pinned by digest unless AllowUnpinnedImages is set
+	KanikoImage             string           `yaml:"KanikoImage"`
+	BuildKitImage           string           `yaml:"BuildKitImage"`
+	CloudSdkImage           string           `yaml:"CloudSdkImage"`
+	CacheTTL                string           `yaml:"CacheTTL"`
+	TTLSecondsAfterFinished int32            `yaml:"TTLSecondsAfterFinished"`
+	ActiveDeadlineSeconds   int64            `yaml:"ActiveDeadlineSeconds"`
+	Requests                BuilderResources `yaml:"Requests"`
+	Limits                  BuilderResources `yaml:"Limits"`
+	// NodePool is the GKE node pool dedicated to builds, its nodes are tainted with TaintKey
+	NodePool string `yaml:"NodePool"`
+	TaintKey string `yaml:"TaintKey"`
+	// PodTemplate is a pod template file in the conf directory, it's strategic merged into the build pod
+	PodTemplate string `yaml:"PodTemplate"`
+	// AllowUnpinnedImages lets the build pods run images referenced by tag, the built images
+	// can't be reproduced from their provenance then
+	AllowUnpinnedImages bool `yaml:"AllowUnpinnedImages"`
+}
+
+// Egress configures the review of job outputs before they are released to the job creator
+type Egress struct {
+	// Reviewers release, redact or reject the outputs in quarantine.
+	// Without reviewers the outputs passing the checks are released right away
+	Reviewers []string `yaml:"Reviewers"`
+	// MaxOutputSize is the size in bytes of the largest output the API reads to inspect it,
+	// larger outputs are blocked. It must be positive, the outputs are inspected in memory
+	MaxOutputSize int64 `yaml:"MaxOutputSize"`
+	// MinRowCount is the minimum number of rows of a tabular output
+	MinRowCount int64 `yaml:"MinRowCount"`
+	// MinCellCount is the smallest count an aggregated cell may show, smaller ones must be suppressed
+	MinCellCount int64 `yaml:"MinCellCount"`
+	// KAnonymity is the minimum size of a group of rows sharing the same quasi-identifiers
+	KAnonymity int64 `yaml:"KAnonymity"`
+	// MaxDistinctRatio is the share of distinct values above which a column is taken for an identifier
+	MaxDistinctRatio float64 `yaml:"MaxDistinctRatio"`
+	// the TEE compares the outputs with SampleRows rows of each input file, an output row copying
+	// VerbatimMinMatches values of an input row blocks the release
+	SampleRows         int   `yaml:"SampleRows"`
+	VerbatimMinMatches int64 `yaml:"VerbatimMinMatches"`
+}
+
+// Attestation configures the custom attestation tokens the jobs request in the TEE
+type Attestation struct {
+	// Audience is the audience of the tokens, verifiers reject tokens issued for another audience
+	Audience string `yaml:"Audience"`
+	// Issuer is the issuer of the tokens, their signatures are checked with the keys of its JWKS
+	Issuer string `yaml:"Issuer"`
+}
+
+// Machines is the catalog of machine profiles the jobs run on
+type Machines struct {
+	// Default is the profile of the jobs that don't select one
+	Default string `yaml:"Default"`
+	// MaxUserCpus caps the CPUs of the in progress jobs of a user, 0 means no cap
+	MaxUserCpus int `yaml:"MaxUserCpus"`
+	// MaxSpotAttempts is how many times the VM of a spot job is launched before its preemptions fail the job
+	MaxSpotAttempts int `yaml:"MaxSpotAttempts"`
+	// Roles maps each role to its users
+	Roles    map[string][]string `yaml:"Roles"`
+	Profiles []MachineProfile    `yaml:"Profiles"`
+}
+
+// Launch tunes the reconciliation of the job launches the API didn't complete
+type Launch struct {
+	// ReconcileInterval is the number of seconds between two reconciliation passes, 300 by default
+	ReconcileInterval int `yaml:"ReconcileInterval"`
+	// StaleAfter is the number of seconds after which an incomplete launch is taken over, 900 by default
+	StaleAfter int `yaml:"StaleAfter"`
+	// PendingTimeout is the number of seconds after which a job queued, building or waiting for approvals
+	// times out, 86400 by default
+	PendingTimeout int `yaml:"PendingTimeout"`
+}
+
+// MachineProfile is a machine shape, the machine family comes from the TEE type of the job
+type MachineProfile struct {
+	Name string `yaml:"Name"`
+	// Series is standard, highmem or highcpu
+	Series   string `yaml:"Series"`
+	Cpus     int    `yaml:"CPUs"`
+	DiskSize int    `yaml:"DiskSize"`
+	// Roles are allowed to use the profile, everyone can use a profile without roles
+	Roles []string `yaml:"Roles"`
+}
+
+type BuilderResources struct {
+	CPU              string `yaml:"CPU"`
+	Memory           string `yaml:"Memory"`
+	EphemeralStorage string `yaml:"EphemeralStorage"`
+}
+
 type GCPConfig struct {
-	Project                    string   `yaml:"Project"`
-	ProjectNumber              uint64   `yaml:"ProjectNumber"`
-	Repository                 string   `yaml:"Repository"`
-	HubBucket                  string   `yaml:"HubBucket"`
-	CvmServiceAccount          string   `yaml:"CvmServiceAccount"`
-	Zone                       string   `yaml:"Zone"`
-	Region                     string   `yaml:"Region"`
-	Cpus                       int      `yaml:"CPUs"`
+	Project           string `yaml:"Project"`
+	ProjectNumber     uint64 `yaml:"ProjectNumber"`
+	Repository        string `yaml:"Repository"`
+	HubBucket         string `yaml:"HubBucket"`
+	CvmServiceAccount string `yaml:"CvmServiceAccount"`
+	Zone              string `yaml:"Zone"`
+	// Zones are the candidate zones of the confidential VMs in order of preference, only Zone if empty
+	Zones  []string `yaml:"Zones"`
+	Region string   `yaml:"Region"`
+	Cpus   int      `yaml:"CPUs"`
+	// TEEType is the confidential instance type of the jobs that don't select one, SEV by default
+	TEEType string `yaml:"TEEType"`
+	// NetworkMode is the egress of the jobs that don't select one, private by default
+	NetworkMode string `yaml:"NetworkMode"`
+	// EgressProxy is the host:port of the allowlisting proxy of the jobs in proxy mode
+	EgressProxy                string   `yaml:"EgressProxy"`
 	DiskSize                   int      `yaml:"DiskSize"`
 	DebugInstanceImageSource   string   `yaml:"DebugInstanceImageSource"`
 	ReleaseInstanceImageSource string   `yaml:"ReleaseInstanceImageSource"`
 	Debug                      bool     `yaml:"Debug"`
 	KeyRing                    string   `yaml:"KeyRing"`
+	ImageSigningKey            string   `yaml:"ImageSigningKey"`
+	ImageSigningKeyVersion     int      `yaml:"ImageSigningKeyVersion"`
 	WorkloadIdentityPool       string   `yaml:"WorkloadIdentityPool"`
 	IssuerUri                  string   `yaml:"IssuerUri"`
 	AllowedAudiences           []string `yaml:"AllowedAudiences"`
@@ -76,6 +186,9 @@ func InitConfig() error {
 	if err := viper.Unmarshal(&Conf); err != nil {
 		return errors.Wrap(err, "failed to unmarshal config")
 	}
+	if Conf.Egress.MaxOutputSize <= 0 {
+		return fmt.Errorf("invalid Egress.MaxOutputSize %d, it must be positive", Conf.Egress.MaxOutputSize)
+	}
 	hlog.Infof("[Config] Conf.CloudProvider: %#v", Conf.CloudProvider)
 	return nil
 }
@@ -92,6 +205,10 @@ func GetKeyFullName(keyName string) string {
 	return fmt.Sprintf("%s/cryptoKeys/%s", GetKeyRing(), keyName)
 }
 
+func GetImageSigningKeyVersion() string {
+	return fmt.Sprintf("%s/cryptoKeyVersions/%d", GetKeyFullName(Conf.CloudProvider.GCP.ImageSigningKey), Conf.CloudProvider.GCP.ImageSigningKeyVersion)
+}
+
 func getServiceAccountEmail(serviceAccount string, project string) string {
 	return fmt.Sprintf("%s@%s.iam.gserviceaccount.com", serviceAccount, project)
 }
@@ -112,16 +229,18 @@ func GetAllowedAudiences() []string {
 	return Conf.CloudProvider.GCP.AllowedAudiences
 }
 
-func GetCreateWipProviderUrl(provider string) string {
-	return fmt.Sprintf("https://iam.googleapis.com/v1/projects/%s/locations/global/workloadIdentityPools/%s/providers?workloadIdentityPoolProviderId=%s", Conf.CloudProvider.GCP.Project, Conf.CloudProvider.GCP.WorkloadIdentityPool, provider)
+func GetIamApiUrl() string {
+	return "https://iam.googleapis.com/v1"
 }
 
-func GetUpdateWipProviderUrl(provider string) string {
-	return fmt.Sprintf("https://iam.googleapis.com/v1/projects/%s/locations/global/workloadIdentityPools/%s/providers/%s?updateMask=attributeCondition", Conf.CloudProvider.GCP.Project, Conf.CloudProvider.GCP.WorkloadIdentityPool, provider)
+func GetWipPoolPath() string {
+	return fmt.Sprintf("projects/%s/locations/global/workloadIdentityPools/%s", Conf.CloudProvider.GCP.Project, Conf.CloudProvider.GCP.WorkloadIdentityPool)
 }
 
-func GetUserWipProvider(user string) string {
-	name := fmt.Sprintf("%s-tee-provider", user)
+// GetJobWipProvider returns the workload identity pool provider of a job, each job gets its own
+// provider so concurrent jobs of a user don't overwrite each other's condition
+func GetJobWipProvider(UUID string) string {
+	name := fmt.Sprintf("job-%s", strings.ReplaceAll(UUID, "-", ""))
 	if len(name) > 32 {
 		return name[:32]
 	}
@@ -132,6 +251,23 @@ func GetZone() string {
 	return Conf.CloudProvider.GCP.Zone
 }
 
+// GetZones returns the candidate zones of the confidential VMs in order of preference
+func GetZones() []string {
+	if len(Conf.CloudProvider.GCP.Zones) == 0 {
+		return []string{GetZone()}
+	}
+	return Conf.CloudProvider.GCP.Zones
+}
+
+// GetZoneRegion returns the region of a zone, us-central1 for us-central1-a
+func GetZoneRegion(zone string) string {
+	i := strings.LastIndex(zone, "-")
+	if i < 0 {
+		return zone
+	}
+	return zone[:i]
+}
+
 func GetRegion() string {
 	return Conf.CloudProvider.GCP.Region
 }
@@ -140,16 +276,207 @@ func GetProject() string {
 	return Conf.CloudProvider.GCP.Project
 }
 
-func GetMachineType() string {
-	return fmt.Sprintf("zones/%s/machineTypes/n2d-standard-%d", GetZone(), Conf.CloudProvider.GCP.Cpus)
+// confidential instance types of the jobs
+const (
+	TEETypeSEV    = "SEV"
+	TEETypeSEVSNP = "SEV_SNP"
+	TEETypeTDX    = "TDX"
+)
+
+// TEEType is a confidential instance type with the machine family that supports it
+type TEEType struct {
+	MachineFamily string
+	// MachineSizes are the CPUs of the machines of the family in each series, smallest first
+	MachineSizes map[string][]int
+	// HardwareModel is the hwmodel claim of the attestation tokens of the instances
+	HardwareModel string
+}
+
+var (
+	n2dMachineSizes = map[string][]int{
+		"standard": {2, 4, 8, 16, 32, 48, 64, 80, 96, 128, 224},
+		"highmem":  {2, 4, 8, 16, 32, 48, 64, 80, 96},
+		"highcpu":  {2, 4, 8, 16, 32, 48, 64, 80, 96, 128, 224},
+	}
+	c3MachineSizes = map[string][]int{
+		"standard": {4, 8, 22, 44, 88, 176},
+		"highmem":  {4, 8, 22, 44, 88, 176},
+		"highcpu":  {4, 8, 22, 44, 88, 176},
+	}
+)
+
+var teeTypes = map[string]TEEType{
+	TEETypeSEV:    {MachineFamily: "n2d", MachineSizes: n2dMachineSizes, HardwareModel: "GCP_AMD_SEV"},
+	TEETypeSEVSNP: {MachineFamily: "n2d", MachineSizes: n2dMachineSizes, HardwareModel: "GCP_AMD_SEV_SNP"},
+	TEETypeTDX:    {MachineFamily: "c3", MachineSizes: c3MachineSizes, HardwareModel: "GCP_INTEL_TDX"},
+}
+
+func LookupTEEType(name string) (TEEType, error) {
+	t, ok := teeTypes[name]
+	if !ok {
+		return TEEType{}, fmt.Errorf("unknown TEE type %s", name)
+	}
+	return t, nil
+}
+
+func GetTEEType() string {
+	if Conf.CloudProvider.GCP.TEEType == "" {
+		return TEETypeSEV
+	}
+	return Conf.CloudProvider.GCP.TEEType
+}
+
+const (
+	// NetworkModePrivate has no external IP and reaches Google APIs through Private Google Access only
+	NetworkModePrivate = "private"
+	// NetworkModeProxy also reaches the egress proxy, which allowlists the destinations
+	NetworkModeProxy = "proxy"
+	// NetworkModeOpen has an external IP and unrestricted egress, it's only allowed in debug deployments
+	NetworkModeOpen = "open"
+)
+
+// ValidateNetworkMode checks the network mode can be used in this deployment
+func ValidateNetworkMode(mode string) error {
+	switch mode {
+	case NetworkModePrivate:
+		return nil
+	case NetworkModeProxy:
+		if Conf.CloudProvider.GCP.EgressProxy == "" {
+			return fmt.Errorf("network mode %s needs an egress proxy", mode)
+		}
+		return nil
+	case NetworkModeOpen:
+		if !IsDebug() {
+			return fmt.Errorf("network mode %s is only allowed in debug deployments", mode)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown network mode %s", mode)
+}
+
+func GetNetworkMode() string {
+	if Conf.CloudProvider.GCP.NetworkMode == "" {
+		return NetworkModePrivate
+	}
+	return Conf.CloudProvider.GCP.NetworkMode
+}
+
+func GetEgressProxy() string {
+	return Conf.CloudProvider.GCP.EgressProxy
+}
+
+// GetNetworkTag returns the network tag of the confidential VMs in the network mode, the firewall rules of
+// the mode target it
+func GetNetworkTag(mode string) string {
+	return "tee-egress-" + mode
+}
+
+// GetMachineCpus returns the CPUs of the machine a job of the TEE type and the profile runs on, the smallest machine
+// of the family of the TEE type in the series of the profile with at least the CPUs of the profile. It fails if the
+// family has no such machine
+func GetMachineCpus(teeType string, profile MachineProfile) (int, error) {
+	t, err := LookupTEEType(teeType)
+	if err != nil {
+		return 0, err
+	}
+	sizes, ok := t.MachineSizes[profile.Series]
+	if !ok {
+		return 0, fmt.Errorf("machine profile %s: %s machines of TEE type %s have no %s series", profile.Name, t.MachineFamily, teeType, profile.Series)
+	}
+	for _, cpus := range sizes {
+		if cpus >= profile.Cpus {
+			return cpus, nil
+		}
+	}
+	return 0, fmt.Errorf("machine profile %s needs %d CPUs, the largest %s-%s machine of TEE type %s has %d",
+		profile.Name, profile.Cpus, t.MachineFamily, profile.Series, teeType, sizes[len(sizes)-1])
+}
+
+// GetMachineType returns the machine of the job of the TEE type and the profile in the zone, see GetMachineCpus
+func GetMachineType(zone string, teeType string, profile MachineProfile) (string, error) {
+	cpus, err := GetMachineCpus(teeType, profile)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("zones/%s/machineTypes/%s-%s-%d", zone, teeTypes[teeType].MachineFamily, profile.Series, cpus), nil
+}
+
+// GetMachineProfile returns the profile with the name, or the default profile if the name is empty.
+// Without a catalog, the only profile is the CPUs and disk size of the GCP configuration
+func GetMachineProfile(name string) (MachineProfile, error) {
+	if len(Conf.Machines.Profiles) == 0 {
+		if name != "" && name != "default" {
+			return MachineProfile{}, fmt.Errorf("unknown machine profile %s", name)
+		}
+		return MachineProfile{
+			Name:     "default",
+			Series:   "standard",
+			Cpus:     Conf.CloudProvider.GCP.Cpus,
+			DiskSize: Conf.CloudProvider.GCP.DiskSize,
+		}, nil
+	}
+	if name == "" {
+		name = Conf.Machines.Default
+	}
+	for _, p := range Conf.Machines.Profiles {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return MachineProfile{}, fmt.Errorf("unknown machine profile %s", name)
+}
+
+// AllowsUser reports whether one of the roles of the profile has the user
+func (p MachineProfile) AllowsUser(user string) bool {
+	if len(p.Roles) == 0 {
+		return true
+	}
+	for _, role := range p.Roles {
+		for _, u := range Conf.Machines.Roles[role] {
+			if u == user {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func GetMaxUserCpus() int {
+	return Conf.Machines.MaxUserCpus
+}
+
+func GetMaxSpotAttempts() int {
+	return Conf.Machines.MaxSpotAttempts
+}
+
+func GetLaunchReconcileInterval() time.Duration {
+	if Conf.Launch.ReconcileInterval <= 0 {
+		return 5 * time.Minute
+	}
+	return time.Duration(Conf.Launch.ReconcileInterval) * time.Second
+}
+
+func GetLaunchStaleAfter() time.Duration {
+	if Conf.Launch.StaleAfter <= 0 {
+		return 15 * time.Minute
+	}
+	return time.Duration(Conf.Launch.StaleAfter) * time.Second
+}
+
+func GetLaunchPendingTimeout() time.Duration {
+	if Conf.Launch.PendingTimeout <= 0 {
+		return 24 * time.Hour
+	}
+	return time.Duration(Conf.Launch.PendingTimeout) * time.Second
 }
 
 func GetNetwork() string {
 	return fmt.Sprintf("https://compute.googleapis.com/compute/v1/projects/%s/global/networks/%s", GetProject(), Conf.CloudProvider.GCP.Network)
 }
 
-func GetSubNetwork() string {
-	return fmt.Sprintf("https://compute.googleapis.com/compute/v1/projects/%s/regions/%s/subnetworks/%s", GetProject(), GetRegion(), Conf.CloudProvider.GCP.Subnetwork)
+// GetSubNetwork returns the subnetwork of the confidential VMs in the region, which must exist in the region of every candidate zone
+func GetSubNetwork(region string) string {
+	return fmt.Sprintf("https://compute.googleapis.com/compute/v1/projects/%s/regions/%s/subnetworks/%s", GetProject(), region, Conf.CloudProvider.GCP.Subnetwork)
 }
 
 func GetEnv() string {
@@ -163,15 +490,18 @@ func GetTEEImageSource() string {
 	return Conf.CloudProvider.GCP.ReleaseInstanceImageSource
 }
 
-func GetCvmDiskSize() int64 {
-	return int64(Conf.CloudProvider.GCP.DiskSize)
-}
-
 func GetWipProviderFullName(provider string) string {
 	return fmt.Sprintf("projects/%v/locations/global/workloadIdentityPools/%s/providers/%s", Conf.CloudProvider.GCP.ProjectNumber, Conf.CloudProvider.GCP.WorkloadIdentityPool, provider)
 }
 
+// GetWipPrincipalSet returns the principal set of the workload identity pool identities with the attribute value
+func GetWipPrincipalSet(attribute string, value string) string {
+	return fmt.Sprintf("principalSet://iam.googleapis.com/projects/%v/locations/global/workloadIdentityPools/%s/attribute.%s/%s", Conf.CloudProvider.GCP.ProjectNumber, Conf.CloudProvider.GCP.WorkloadIdentityPool, attribute, value)
+}
+
+// GetJobOutputFilename flattens the path of an output in the output directory of the job into the file name
 func GetJobOutputFilename(UUID string, originName string) string {
+	originName = strings.ReplaceAll(originName, "/", "_")
 	if len(UUID) >= 8 {
 		return fmt.Sprintf("out-%s-%s", UUID[:8], originName)
 	}
@@ -190,12 +520,40 @@ func GetJobOutputPath(creator string, UUID string, originName string) string {
 	return fmt.Sprintf("%s/output/%s", creator, GetJobOutputFilename(UUID, originName))
 }
 
+// GetJobQuarantinePath is where the TEE writes an output, it's only readable by the API until it's released
+func GetJobQuarantinePath(creator string, UUID string, filename string) string {
+	return fmt.Sprintf("quarantine/%s/%s/%s", creator, UUID, filename)
+}
+
+// GetJobInspectionReportPath is where the TEE writes the comparison of the outputs with the input data
+func GetJobInspectionReportPath(creator string, UUID string) string {
+	return fmt.Sprintf("quarantine/%s/%s-inspection.json", creator, UUID)
+}
+
+// GetJobManifestPath is where the TEE writes the manifest of the outputs, its root hash is the attestation nonce
+func GetJobManifestPath(creator string, UUID string) string {
+	return fmt.Sprintf("quarantine/%s/%s-manifest.json", creator, UUID)
+}
+
+// GetJobCommitmentPath is where the TEE writes the commitment whose hash is the nonce of the attestation token
+func GetJobCommitmentPath(creator string, UUID string) string {
+	return fmt.Sprintf("quarantine/%s/%s-commitment.json", creator, UUID)
+}
+
 func GetCustomTokenPath(creator string, UUID string) string {
 	return fmt.Sprintf("%s/output/%s-token", creator, UUID)
 }
 
+func GetDockerRegistryHost() string {
+	return "us-docker.pkg.dev"
+}
+
+func GetBaseDockerImageRepository() string {
+	return fmt.Sprintf("%s/%s/%s/%s", GetDockerRegistryHost(), GetProject(), Conf.CloudProvider.GCP.Repository, "data-clean-room-base")
+}
+
 func GetBaseDockerImage() string {
-	return fmt.Sprintf("us-docker.pkg.dev/%s/%s/%s:latest", GetProject(), Conf.CloudProvider.GCP.Repository, "data-clean-room-base")
+	return fmt.Sprintf("%s:latest", GetBaseDockerImageRepository())
 }
 
 func GetCloudStoragePath(file string) string {
@@ -206,8 +564,12 @@ func GetJobDockerImageName(creator, UUID string) string {
 	return fmt.Sprintf("%s-%s", creator, UUID)
 }
 
+func GetJobDockerImageRepository(creator string, UUID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", GetDockerRegistryHost(), GetProject(), Conf.CloudProvider.GCP.Repository, GetJobDockerImageName(creator, UUID))
+}
+
 func GetJobDockerImageFull(creator string, UUID string) string {
-	return fmt.Sprintf("us-docker.pkg.dev/%s/%s/%s:latest", GetProject(), Conf.CloudProvider.GCP.Repository, GetJobDockerImageName(creator, UUID))
+	return fmt.Sprintf("%s:latest", GetJobDockerImageRepository(creator, UUID))
 }
 
 func GetUserWorkspaceFile(creator string) string {
@@ -230,10 +592,40 @@ func GetBuildContextPath(creator, UUID string) string {
 	return fmt.Sprintf("%s/%s", creator, GetBuildContextFileName(UUID))
 }
 
+func GetBuildInputsPath(creator, UUID string) string {
+	return fmt.Sprintf("%s/%s-build-inputs.json", creator, UUID)
+}
+
+func GetJobNotebookPath(creator, UUID string) string {
+	return fmt.Sprintf("%s/%s-notebook.ipynb", creator, UUID)
+}
+
+func GetJobSbomPath(creator, UUID string) string {
+	return fmt.Sprintf("%s/%s-sbom.spdx.json", creator, UUID)
+}
+
+func GetJobProvenancePath(creator, UUID string) string {
+	return fmt.Sprintf("%s/%s-provenance.intoto.json", creator, UUID)
+}
+
 func GetUserKey(user string) string {
 	return fmt.Sprintf("%s-key", user)
 }
 
+func GetDatasetKey(name string) string {
+	return fmt.Sprintf("dataset-%s-key", name)
+}
+
+// GetDatasetStoragePath returns the directory of the encrypted data of the dataset
+func GetDatasetStoragePath(name string) string {
+	return fmt.Sprintf("datasets/%s/data/", name)
+}
+
+// GetDatasetPreviewPath returns the path of a stage-1 preview file of the dataset
+func GetDatasetPreviewPath(name string, version int64, filename string) string {
+	return fmt.Sprintf("datasets/%s/preview/%d/%s", name, version, filename)
+}
+
 func GetK8sPodServiceAccount() string {
 	return Conf.Cluster.PodServiceAccount
 }
@@ -241,3 +633,40 @@ func GetK8sPodServiceAccount() string {
 func GetInstanceName(creator string, UUID string) string {
 	return fmt.Sprintf("%s-%s", creator, UUID[:8])
 }
+
+// GetInstanceAttemptName is the instance name of a launch of the job, the first launch has the instance name
+func GetInstanceAttemptName(creator string, UUID string, attempt int) string {
+	if attempt <= 1 {
+		return GetInstanceName(creator, UUID)
+	}
+	return fmt.Sprintf("%s-%d", GetInstanceName(creator, UUID), attempt)
+}
+
+func GetEgressConfig() Egress {
+	return Conf.Egress
+}
+
+func IsEgressReviewer(name string) bool {
+	for _, r := range Conf.Egress.Reviewers {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
+
+func GetAttestationAudience() string {
+	return Conf.Attestation.Audience
+}
+
+func GetAttestationIssuer() string {
+	return Conf.Attestation.Issuer
+}
+
+func GetBuilderType() string {
+	return Conf.Builder.Type
+}
+
+func GetBuilderConfig() Builder {
+	return Conf.Builder
+}
@@ -22,12 +22,20 @@ const (
 	SuccessCode    = 0
 	ServiceErrCode = iota + 10000
 	ReachJobLimitErrCode
+	PrivacyBudgetExceededErrCode
+	CpuQuotaExceededErrCode
+	IllegalJobTransitionErrCode
+	JobVersionConflictErrCode
 )
 
 const (
-	SuccessMsg          = "Success"
-	ServiceErrMsg       = "Service internal error"
-	ReachJobLimitErrMsg = "The number of in progress jobs has reached the limit"
+	SuccessMsg                  = "Success"
+	ServiceErrMsg               = "Service internal error"
+	ReachJobLimitErrMsg         = "The number of in progress jobs has reached the limit"
+	PrivacyBudgetExceededErrMsg = "The privacy budget of the dataset is exceeded"
+	CpuQuotaExceededErrMsg      = "The CPUs of the in progress jobs have reached the quota"
+	IllegalJobTransitionErrMsg  = "The job can't move to the requested status"
+	JobVersionConflictErrMsg    = "The job was updated by another request"
 )
 
 type ErrNo struct {
@@ -48,8 +56,18 @@ func (e ErrNo) WithMessage(msg string) ErrNo {
 	return e
 }
 
+// Is matches errors by code, so errors.Is finds an error whatever its message
+func (e ErrNo) Is(target error) bool {
+	t, ok := target.(ErrNo)
+	return ok && t.ErrCode == e.ErrCode
+}
+
 var (
-	Success          = NewErrNo(SuccessCode, SuccessMsg)
-	ServiceErr       = NewErrNo(ServiceErrCode, ServiceErrMsg)
-	ReachJobLimitErr = NewErrNo(ReachJobLimitErrCode, ReachJobLimitErrMsg)
+	Success                  = NewErrNo(SuccessCode, SuccessMsg)
+	ServiceErr               = NewErrNo(ServiceErrCode, ServiceErrMsg)
+	ReachJobLimitErr         = NewErrNo(ReachJobLimitErrCode, ReachJobLimitErrMsg)
+	PrivacyBudgetExceededErr = NewErrNo(PrivacyBudgetExceededErrCode, PrivacyBudgetExceededErrMsg)
+	CpuQuotaExceededErr      = NewErrNo(CpuQuotaExceededErrCode, CpuQuotaExceededErrMsg)
+	IllegalJobTransitionErr  = NewErrNo(IllegalJobTransitionErrCode, IllegalJobTransitionErrMsg)
+	JobVersionConflictErr    = NewErrNo(JobVersionConflictErrCode, JobVersionConflictErrMsg)
 )
@@ -0,0 +1,124 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspection
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+const (
+	CheckSize         = "size"
+	CheckRowCount     = "row_count"
+	CheckSmallCell    = "small_cell"
+	CheckKAnonymity   = "k_anonymity"
+	CheckIdentifier   = "identifier"
+	CheckVerbatim     = "verbatim"
+	CheckUnreadable   = "unreadable"
+	CheckIntegrity    = "integrity"
+	maxFindingsOfKind = 20
+)
+
+// Limits are the thresholds of the checks, a zero limit disables its check
+type Limits struct {
+	MaxSize      int64
+	MinRowCount  int64
+	MinCellCount int64
+	KAnonymity   int64
+	// MaxDistinctRatio is the share of distinct values above which a column identifies individuals
+	MaxDistinctRatio float64
+	// VerbatimMinMatches is how many values of one input row an output row or line must contain to be a copy of it
+	VerbatimMinMatches int64
+}
+
+// Finding is a problem found in an output, any finding blocks the release
+type Finding struct {
+	Check    string `json:"check"`
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+// Report is the result of inspecting an output
+type Report struct {
+	Artifact string    `json:"artifact"`
+	Size     int64     `json:"size"`
+	Findings []Finding `json:"findings"`
+}
+
+// Blocked reports whether the output must not be released without review
+func (r *Report) Blocked() bool {
+	return len(r.Findings) > 0
+}
+
+func (r *Report) add(check string, location string, format string, args ...interface{}) {
+	r.Findings = append(r.Findings, Finding{Check: check, Location: location, Message: fmt.Sprintf(format, args...)})
+}
+
+// Merge adds the findings of another inspection of the same output, such as the one run in the TEE
+func (r *Report) Merge(other *Report) {
+	r.Findings = append(r.Findings, other.Findings...)
+}
+
+// Inspect runs the checks matching the type of the output, content is nil if the output is too large to be read.
+// Outputs are only compared with the input data if a sample of it is given
+func Inspect(artifact string, size int64, content []byte, limits Limits, sample *Sample) *Report {
+	r := &Report{Artifact: artifact, Size: size, Findings: []Finding{}}
+	if limits.MaxSize > 0 && size > limits.MaxSize {
+		r.add(CheckSize, artifact, "size %d exceeds the limit %d", size, limits.MaxSize)
+		return r
+	}
+	var tables []*Table
+	var texts []string
+	var err error
+	switch strings.ToLower(path.Ext(artifact)) {
+	case ".csv":
+		var table *Table
+		table, err = ReadCSV(artifact, content)
+		tables = []*Table{table}
+	case ".parquet":
+		var table *Table
+		table, err = ReadParquet(artifact, content)
+		tables = []*Table{table}
+	case ".ipynb":
+		tables, texts, err = ReadNotebook(artifact, content)
+	default:
+		texts = []string{string(content)}
+	}
+	if err != nil {
+		r.add(CheckUnreadable, artifact, "%v", err)
+		return r
+	}
+	for _, t := range tables {
+		inspectTable(r, t, limits)
+		checkIdentifierColumns(r, t, limits.MaxDistinctRatio)
+		sample.checkTable(r, t, limits.VerbatimMinMatches)
+	}
+	for _, text := range texts {
+		sample.checkText(r, artifact, text, limits.VerbatimMinMatches)
+	}
+	return r
+}
+
+// ReadTable reads a tabular file by its extension, it returns nil for other files
+func ReadTable(name string, content []byte) (*Table, error) {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".csv":
+		return ReadCSV(name, content)
+	case ".parquet":
+		return ReadParquet(name, content)
+	}
+	return nil, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspection
+
+import (
+	"strings"
+	"testing"
+)
+
+// csvOf joins the header and the rows of a CSV output
+func csvOf(lines ...string) []byte {
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
+
+// findingsOf returns the findings of the check in the report
+func findingsOf(r *Report, check string) []Finding {
+	found := []Finding{}
+	for _, f := range r.Findings {
+		if f.Check == check {
+			found = append(found, f)
+		}
+	}
+	return found
+}
+
+// inspect inspects the output and checks that only the expected check found something, and how often
+func inspect(t *testing.T, artifact string, content []byte, limits Limits, sample *Sample, check string, want int) *Report {
+	t.Helper()
+	r := Inspect(artifact, int64(len(content)), content, limits, sample)
+	if got := len(findingsOf(r, check)); got != want {
+		t.Fatalf("got %d %s findings, want %d: %+v", got, check, want, r.Findings)
+	}
+	if len(r.Findings) != want {
+		t.Fatalf("unexpected findings %+v", r.Findings)
+	}
+	if r.Blocked() != (want > 0) {
+		t.Fatalf("blocked is %v with %d findings", r.Blocked(), want)
+	}
+	return r
+}
+
+func TestInspectSize(t *testing.T) {
+	content := csvOf("city,count", "springfield,12")
+	inspect(t, "out.csv", content, Limits{MaxSize: int64(len(content))}, nil, CheckSize, 0)
+	r := inspect(t, "out.csv", content, Limits{MaxSize: int64(len(content)) - 1}, nil, CheckSize, 1)
+	if r.Findings[0].Location != "out.csv" {
+		t.Fatalf("unexpected location %s", r.Findings[0].Location)
+	}
+	// an output over the limit isn't read, the API passes no content
+	r = Inspect("out.csv", 1<<30, nil, Limits{MaxSize: 1 << 20, MinRowCount: 10}, nil)
+	if len(r.Findings) != 1 || r.Findings[0].Check != CheckSize {
+		t.Fatalf("unexpected findings %+v", r.Findings)
+	}
+}
+
+func TestInspectUnreadable(t *testing.T) {
+	inspect(t, "out.csv", []byte("city,count\n\"springfield,12\n"), Limits{}, nil, CheckUnreadable, 1)
+	inspect(t, "out.parquet", []byte("not parquet"), Limits{}, nil, CheckUnreadable, 1)
+	inspect(t, "job.ipynb", []byte("{"), Limits{}, nil, CheckUnreadable, 1)
+}
+
+func TestReportMerge(t *testing.T) {
+	r := Inspect("out.txt", 5, []byte("hello"), Limits{}, nil)
+	if r.Blocked() {
+		t.Fatalf("unexpected findings %+v", r.Findings)
+	}
+	r.Merge(&Report{Findings: []Finding{{Check: CheckVerbatim, Location: "out.txt line 1"}}})
+	if !r.Blocked() || len(r.Findings) != 1 {
+		t.Fatalf("the merged findings are missing %+v", r.Findings)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspection
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+)
+
+type notebook struct {
+	Cells []struct {
+		Outputs []struct {
+			OutputType string                     `json:"output_type"`
+			Text       json.RawMessage            `json:"text"`
+			Data       map[string]json.RawMessage `json:"data"`
+		} `json:"outputs"`
+	} `json:"cells"`
+}
+
+// notebook strings are either a string or a list of lines
+func notebookString(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var lines []string
+	if err := json.Unmarshal(raw, &lines); err == nil {
+		return strings.Join(lines, "")
+	}
+	return ""
+}
+
+// ReadNotebook extracts the DataFrames rendered in the outputs of an executed notebook as tables,
+// and the printed outputs as texts
+func ReadNotebook(name string, content []byte) ([]*Table, []string, error) {
+	nb := notebook{}
+	if err := json.Unmarshal(content, &nb); err != nil {
+		return nil, nil, errors.Wrap(err, fmt.Sprintf("failed to parse %s as notebook", name))
+	}
+	tables := []*Table{}
+	texts := []string{}
+	for i, cell := range nb.Cells {
+		for j, output := range cell.Outputs {
+			if text := notebookString(output.Text); text != "" {
+				texts = append(texts, text)
+			}
+			if text := notebookString(output.Data["text/plain"]); text != "" {
+				texts = append(texts, text)
+			}
+			htmlText := notebookString(output.Data["text/html"])
+			if htmlText == "" {
+				continue
+			}
+			found, err := readHTMLTables(fmt.Sprintf("%s cell %d output %d", name, i+1, j+1), htmlText)
+			if err != nil {
+				return nil, nil, err
+			}
+			tables = append(tables, found...)
+		}
+	}
+	return tables, texts, nil
+}
+
+// readHTMLTables reads the tables pandas renders for DataFrames, the index is read as a column named index
+func readHTMLTables(name string, text string) ([]*Table, error) {
+	root, err := html.Parse(strings.NewReader(text))
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to parse html of %s", name))
+	}
+	tables := []*Table{}
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "table" {
+			tables = append(tables, readHTMLTable(fmt.Sprintf("%s table %d", name, len(tables)+1), n))
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(root)
+	return tables, nil
+}
+
+func readHTMLTable(name string, table *html.Node) *Table {
+	t := &Table{Name: name}
+	var walk func(n *html.Node, inHead bool)
+	walk = func(n *html.Node, inHead bool) {
+		if n.Type == html.ElementNode && n.Data == "tr" {
+			cells := []string{}
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				if c.Type == html.ElementNode && (c.Data == "th" || c.Data == "td") {
+					cells = append(cells, strings.TrimSpace(nodeText(c)))
+				}
+			}
+			if inHead {
+				// with several header rows, such as the names of the index, the last one names the columns
+				t.Header = cells
+			} else {
+				t.Rows = append(t.Rows, cells)
+			}
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c, inHead || (n.Type == html.ElementNode && n.Data == "thead"))
+		}
+	}
+	walk(table, false)
+	width := len(t.Header)
+	for _, row := range t.Rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+	// the index column has no header
+	for len(t.Header) < width {
+		t.Header = append([]string{"index"}, t.Header...)
+	}
+	for i, row := range t.Rows {
+		for len(row) < width {
+			row = append(row, "")
+		}
+		t.Rows[i] = row
+	}
+	if len(t.Header) > 0 && t.Header[0] == "" {
+		t.Header[0] = "index"
+	}
+	return t
+}
+
+func nodeText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(nodeText(c))
+	}
+	return b.String()
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspection
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+// dataFrameHTML renders the counts of the cities as pandas renders a DataFrame
+func dataFrameHTML(counts map[string]int) string {
+	rows := ""
+	for i, city := range []string{"springfield", "shelbyville"} {
+		rows += fmt.Sprintf("<tr><th>%d</th><td>%s</td><td>%d</td></tr>", i, city, counts[city])
+	}
+	return `<div><table class="dataframe"><thead><tr style="text-align: right;"><th></th><th>city</th><th>count</th></tr>` +
+		`</thead><tbody>` + rows + `</tbody></table></div>`
+}
+
+// notebookOf builds an executed notebook printing the text and displaying the html
+func notebookOf(t *testing.T, text string, html string) []byte {
+	t.Helper()
+	nb := map[string]any{
+		"cells": []any{
+			map[string]any{"cell_type": "markdown", "source": "# counts"},
+			map[string]any{"cell_type": "code", "outputs": []any{
+				map[string]any{"output_type": "stream", "name": "stdout", "text": []string{text, "\n"}},
+				map[string]any{"output_type": "execute_result", "data": map[string]any{
+					"text/plain": "the counts",
+					"text/html":  []string{html},
+				}},
+			}},
+		},
+	}
+	content, err := json.Marshal(nb)
+	if err != nil {
+		t.Fatalf("failed to marshal notebook: %v", err)
+	}
+	return content
+}
+
+func TestReadNotebook(t *testing.T) {
+	content := notebookOf(t, "done", dataFrameHTML(map[string]int{"springfield": 12, "shelbyville": 3}))
+	tables, texts, err := ReadNotebook("job.ipynb", content)
+	if err != nil {
+		t.Fatalf("failed to read notebook: %v", err)
+	}
+	if len(texts) != 2 || texts[0] != "done\n" || texts[1] != "the counts" {
+		t.Fatalf("unexpected texts %q", texts)
+	}
+	if len(tables) != 1 {
+		t.Fatalf("got %d tables, want 1", len(tables))
+	}
+	table := tables[0]
+	if table.Name != "job.ipynb cell 2 output 2 table 1" {
+		t.Fatalf("unexpected name %s", table.Name)
+	}
+	if fmt.Sprint(table.Header) != "[index city count]" || fmt.Sprint(table.Rows) != "[[0 springfield 12] [1 shelbyville 3]]" {
+		t.Fatalf("unexpected table %v %v", table.Header, table.Rows)
+	}
+}
+
+func TestInspectNotebook(t *testing.T) {
+	limits := Limits{MinCellCount: 10}
+	content := notebookOf(t, "done", dataFrameHTML(map[string]int{"springfield": 12, "shelbyville": 30}))
+	inspect(t, "job.ipynb", content, limits, nil, CheckSmallCell, 0)
+	content = notebookOf(t, "done", dataFrameHTML(map[string]int{"springfield": 12, "shelbyville": 3}))
+	r := inspect(t, "job.ipynb", content, limits, nil, CheckSmallCell, 1)
+	if r.Findings[0].Location != "job.ipynb cell 2 output 2 table 1 row 2 column count" {
+		t.Fatalf("unexpected location %s", r.Findings[0].Location)
+	}
+}
+
+func TestInspectNotebookPrintedRows(t *testing.T) {
+	sample := NewSample([]*Table{patientTable()}, 0)
+	limits := Limits{VerbatimMinMatches: 2}
+	inspect(t, "job.ipynb", notebookOf(t, "mean age 42.5", ""), limits, sample, CheckVerbatim, 0)
+	r := inspect(t, "job.ipynb", notebookOf(t, "print(df.head()): Homer.Simpson@example.com  742 Evergreen Terrace", ""),
+		limits, sample, CheckVerbatim, 1)
+	if r.Findings[0].Location != "job.ipynb line 1" {
+		t.Fatalf("unexpected location %s", r.Findings[0].Location)
+	}
+}
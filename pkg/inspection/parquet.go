@@ -0,0 +1,65 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspection
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/pkg/errors"
+)
+
+// ReadParquet reads every row of a parquet output, nested columns are named by their dotted path
+func ReadParquet(name string, content []byte) (*Table, error) {
+	file, err := parquet.OpenFile(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to parse %s as parquet", name))
+	}
+	t := &Table{Name: name}
+	for _, column := range file.Schema().Columns() {
+		t.Header = append(t.Header, strings.Join(column, "."))
+	}
+	buffer := make([]parquet.Row, 128)
+	for _, rowGroup := range file.RowGroups() {
+		rows := rowGroup.Rows()
+		for {
+			n, err := rows.ReadRows(buffer)
+			for _, row := range buffer[:n] {
+				record := make([]string, len(t.Header))
+				for _, value := range row {
+					if column := value.Column(); column >= 0 && column < len(record) && !value.IsNull() {
+						// repeated columns keep their first value
+						if record[column] == "" {
+							record[column] = value.String()
+						}
+					}
+				}
+				t.Rows = append(t.Rows, record)
+			}
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				rows.Close()
+				return nil, errors.Wrap(err, fmt.Sprintf("failed to read rows of %s", name))
+			}
+		}
+		rows.Close()
+	}
+	return t, nil
+}
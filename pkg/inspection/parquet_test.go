@@ -0,0 +1,60 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspection
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+type cityCount struct {
+	City  string `parquet:"city"`
+	Count int64  `parquet:"count"`
+}
+
+func parquetOf(t *testing.T, rows []cityCount) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	if err := parquet.Write(&b, rows); err != nil {
+		t.Fatalf("failed to write parquet: %v", err)
+	}
+	return b.Bytes()
+}
+
+func TestReadParquet(t *testing.T) {
+	table, err := ReadParquet("out.parquet", parquetOf(t, []cityCount{{"springfield", 12}, {"shelbyville", 3}}))
+	if err != nil {
+		t.Fatalf("failed to read parquet: %v", err)
+	}
+	if len(table.Header) != 2 || table.Header[0] != "city" || table.Header[1] != "count" {
+		t.Fatalf("unexpected header %v", table.Header)
+	}
+	if len(table.Rows) != 2 || table.Rows[1][0] != "shelbyville" || table.Rows[1][1] != "3" {
+		t.Fatalf("unexpected rows %v", table.Rows)
+	}
+}
+
+func TestInspectParquet(t *testing.T) {
+	limits := Limits{MinCellCount: 10}
+	inspect(t, "out.parquet", parquetOf(t, []cityCount{{"springfield", 12}, {"shelbyville", 30}}),
+		limits, nil, CheckSmallCell, 0)
+	r := inspect(t, "out.parquet", parquetOf(t, []cityCount{{"springfield", 12}, {"shelbyville", 3}}),
+		limits, nil, CheckSmallCell, 1)
+	if r.Findings[0].Location != "out.parquet row 2 column count" {
+		t.Fatalf("unexpected location %s", r.Findings[0].Location)
+	}
+}
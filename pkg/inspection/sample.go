@@ -0,0 +1,149 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspection
+
+import (
+	"fmt"
+	"strings"
+)
+
+// values too common to tell that a row was copied
+var commonValues = map[string]bool{"true": true, "false": true, "null": true, "none": true, "nan": true, "n/a": true}
+
+// Sample is a sample of the rows of the input data, outputs must not copy its rows
+type Sample struct {
+	// rows are the distinctive values of each sampled row
+	rows [][]string
+	// rowsOfValue indexes the sampled rows by their distinctive values
+	rowsOfValue map[string][]int
+}
+
+func normalize(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// isDistinctive reports whether finding the value in an output tells anything about the input row
+func isDistinctive(value string) bool {
+	if len(value) < 4 || commonValues[value] {
+		return false
+	}
+	// small numbers are everywhere
+	return !isNumeric(value) || len(value) >= 6
+}
+
+// NewSample samples up to maxRows rows of each input table
+func NewSample(tables []*Table, maxRows int) *Sample {
+	s := &Sample{rowsOfValue: map[string][]int{}}
+	for _, t := range tables {
+		step := 1
+		if maxRows > 0 && len(t.Rows) > maxRows {
+			step = len(t.Rows) / maxRows
+		}
+		for i := 0; i < len(t.Rows); i += step {
+			values := []string{}
+			seen := map[string]bool{}
+			for _, cell := range t.Rows[i] {
+				value := normalize(cell)
+				if isDistinctive(value) && !seen[value] {
+					seen[value] = true
+					values = append(values, value)
+				}
+			}
+			if len(values) == 0 {
+				continue
+			}
+			id := len(s.rows)
+			s.rows = append(s.rows, values)
+			for _, value := range values {
+				s.rowsOfValue[value] = append(s.rowsOfValue[value], id)
+			}
+		}
+	}
+	return s
+}
+
+// minMatches is how many values of the input row must be found, rows with fewer values must be found entirely
+func (s *Sample) minMatches(id int, verbatimMinMatches int64) int {
+	if int(verbatimMinMatches) < len(s.rows[id]) {
+		return int(verbatimMinMatches)
+	}
+	return len(s.rows[id])
+}
+
+// checkTable finds output rows holding enough values of one sampled input row
+func (s *Sample) checkTable(r *Report, t *Table, verbatimMinMatches int64) {
+	if s == nil || verbatimMinMatches <= 0 {
+		return
+	}
+	found := 0
+	for i, row := range t.Rows {
+		hits := map[int]int{}
+		seen := map[string]bool{}
+		for _, cell := range row {
+			value := normalize(cell)
+			if seen[value] {
+				continue
+			}
+			seen[value] = true
+			for _, id := range s.rowsOfValue[value] {
+				hits[id]++
+			}
+		}
+		for id, n := range hits {
+			if n >= s.minMatches(id, verbatimMinMatches) {
+				found++
+				if found <= maxFindingsOfKind {
+					r.add(CheckVerbatim, fmt.Sprintf("%s row %d", t.Name, i+1), "%d values match a row of the input data", n)
+				}
+				break
+			}
+		}
+	}
+	if found > maxFindingsOfKind {
+		r.add(CheckVerbatim, t.Name, "%d more rows match the input data", found-maxFindingsOfKind)
+	}
+}
+
+// checkText finds lines of printed output holding enough values of one sampled input row
+func (s *Sample) checkText(r *Report, name string, text string, verbatimMinMatches int64) {
+	if s == nil || verbatimMinMatches <= 0 {
+		return
+	}
+	found := 0
+	for i, line := range strings.Split(text, "\n") {
+		line = normalize(line)
+		if line == "" {
+			continue
+		}
+		for id, values := range s.rows {
+			n := 0
+			for _, value := range values {
+				if strings.Contains(line, value) {
+					n++
+				}
+			}
+			if n >= s.minMatches(id, verbatimMinMatches) {
+				found++
+				if found <= maxFindingsOfKind {
+					r.add(CheckVerbatim, fmt.Sprintf("%s line %d", name, i+1), "%d values match a row of the input data", n)
+				}
+				break
+			}
+		}
+	}
+	if found > maxFindingsOfKind {
+		r.add(CheckVerbatim, name, "%d more lines match the input data", found-maxFindingsOfKind)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspection
+
+import (
+	"testing"
+)
+
+// patientTable is the input data the outputs must not copy
+func patientTable() *Table {
+	return &Table{
+		Name:   "patients.csv",
+		Header: []string{"email", "address", "zip", "smoker", "visits"},
+		Rows: [][]string{
+			{"homer.simpson@example.com", "742 Evergreen Terrace", "490035", "true", "12"},
+			{"ned.flanders@example.com", "744 Evergreen Terrace", "490036", "false", "3"},
+			{"moe.szyslak@example.com", "57 Walnut Street", "490037", "true", "40"},
+		},
+	}
+}
+
+func TestNewSample(t *testing.T) {
+	s := NewSample([]*Table{patientTable()}, 0)
+	if len(s.rows) != 3 {
+		t.Fatalf("got %d sampled rows, want 3", len(s.rows))
+	}
+	// short numbers and common values tell nothing about the row
+	for _, value := range []string{"true", "12", "false"} {
+		if len(s.rowsOfValue[value]) > 0 {
+			t.Fatalf("sampled the common value %s", value)
+		}
+	}
+	if got := s.rows[0]; len(got) != 3 || got[0] != "homer.simpson@example.com" || got[2] != "490035" {
+		t.Fatalf("unexpected sampled row %q", got)
+	}
+	if s = NewSample([]*Table{patientTable()}, 1); len(s.rows) != 1 {
+		t.Fatalf("got %d sampled rows, want 1", len(s.rows))
+	}
+}
+
+func TestInspectVerbatimRows(t *testing.T) {
+	sample := NewSample([]*Table{patientTable()}, 0)
+	limits := Limits{VerbatimMinMatches: 2}
+	tests := []struct {
+		name    string
+		content []byte
+		want    int
+	}{
+		{name: "aggregate", content: csvOf("smoker,mean_visits", "true,26", "false,3")},
+		{name: "one value of a row", content: csvOf("address,score", "742 Evergreen Terrace,1")},
+		{name: "values of different rows", content: csvOf("email,zip", "homer.simpson@example.com,490036")},
+		{name: "copied row", content: csvOf("contact,where", " Homer.Simpson@example.com ,742 evergreen terrace"), want: 1},
+		{name: "copied rows", content: csvOf("email,zip",
+			"ned.flanders@example.com,490036", "moe.szyslak@example.com,490037"), want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inspect(t, "out.csv", tt.content, limits, sample, CheckVerbatim, tt.want)
+		})
+	}
+}
+
+func TestInspectVerbatimText(t *testing.T) {
+	sample := NewSample([]*Table{patientTable()}, 0)
+	limits := Limits{VerbatimMinMatches: 2}
+	inspect(t, "out.txt", []byte("2 smokers live on Evergreen Terrace\n"), limits, sample, CheckVerbatim, 0)
+	r := inspect(t, "out.txt", []byte("summary\nmoe.szyslak@example.com lives at 57 Walnut Street\n"),
+		limits, sample, CheckVerbatim, 1)
+	if r.Findings[0].Location != "out.txt line 2" {
+		t.Fatalf("unexpected location %s", r.Findings[0].Location)
+	}
+	// without a sample the outputs aren't compared with the input data
+	inspect(t, "out.txt", []byte("moe.szyslak@example.com 57 Walnut Street\n"), limits, nil, CheckVerbatim, 0)
+}
+
+func TestInspectVerbatimShortRow(t *testing.T) {
+	// a row with fewer distinctive values than the minimum must be found entirely
+	sample := NewSample([]*Table{{Name: "codes.csv", Header: []string{"code", "flag"}, Rows: [][]string{{"X-7731", "true"}}}}, 0)
+	limits := Limits{VerbatimMinMatches: 3}
+	inspect(t, "out.csv", csvOf("code", "x-7731"), limits, sample, CheckVerbatim, 1)
+	inspect(t, "out.csv", csvOf("code", "x-7732"), limits, sample, CheckVerbatim, 0)
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspection
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// columns whose name looks like one of these hold counts of individuals
+var countColumnPattern = regexp.MustCompile(`(?i)^(n|cnt|count|counts|num|number|freq|frequency|total|size)$|(?i)(_count|_cnt|_num|_total)$|(?i)^(count_|num_|n_)`)
+
+// columns whose name looks like one of these identify individuals
+var identifierColumnPattern = regexp.MustCompile(`(?i)(^|_)(id|uuid|email|phone|ssn|name|user|username|account|ip|address)($|_)`)
+
+// a column needs this many rows before its cardinality tells anything
+const minRowsForCardinality = 10
+
+// Table is a tabular output, every row has the length of the header
+type Table struct {
+	Name   string
+	Header []string
+	Rows   [][]string
+}
+
+// ReadCSV parses a CSV output whose first record is the header
+func ReadCSV(name string, content []byte) (*Table, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = 0
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to parse %s as csv", name))
+	}
+	if len(records) == 0 {
+		return &Table{Name: name}, nil
+	}
+	return &Table{Name: name, Header: records[0], Rows: records[1:]}, nil
+}
+
+func isCountColumn(name string) bool {
+	return countColumnPattern.MatchString(strings.TrimSpace(name))
+}
+
+func isNumeric(value string) bool {
+	_, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	return err == nil
+}
+
+// inspectTable checks aggregated tables for small cells, and row-level tables for their size and k-anonymity.
+// A table is aggregated if one of its columns holds counts
+func inspectTable(r *Report, t *Table, limits Limits) {
+	countColumns := []int{}
+	for i, name := range t.Header {
+		if isCountColumn(name) {
+			countColumns = append(countColumns, i)
+		}
+	}
+	if len(countColumns) > 0 {
+		checkSmallCells(r, t, countColumns, limits.MinCellCount)
+		return
+	}
+	if limits.MinRowCount > 0 && int64(len(t.Rows)) < limits.MinRowCount {
+		r.add(CheckRowCount, t.Name, "%d rows is below the minimum %d", len(t.Rows), limits.MinRowCount)
+	}
+	checkKAnonymity(r, t, limits.KAnonymity)
+}
+
+// checkSmallCells finds counts between 1 and the minimum, zero counts reveal nobody
+func checkSmallCells(r *Report, t *Table, countColumns []int, minCellCount int64) {
+	if minCellCount <= 0 {
+		return
+	}
+	found := 0
+	for i, row := range t.Rows {
+		for _, c := range countColumns {
+			value, err := strconv.ParseFloat(strings.TrimSpace(row[c]), 64)
+			if err != nil || value <= 0 || value >= float64(minCellCount) {
+				continue
+			}
+			found++
+			if found <= maxFindingsOfKind {
+				r.add(CheckSmallCell, fmt.Sprintf("%s row %d column %s", t.Name, i+1, t.Header[c]),
+					"count %s is below the minimum %d", row[c], minCellCount)
+			}
+		}
+	}
+	if found > maxFindingsOfKind {
+		r.add(CheckSmallCell, t.Name, "%d more small cells", found-maxFindingsOfKind)
+	}
+}
+
+// checkKAnonymity groups the rows by their non-numeric columns, the quasi-identifiers,
+// every group must have at least k rows
+func checkKAnonymity(r *Report, t *Table, k int64) {
+	if k <= 0 || len(t.Rows) == 0 {
+		return
+	}
+	quasiIdentifiers := []int{}
+	for c := range t.Header {
+		numeric := true
+		for _, row := range t.Rows {
+			if strings.TrimSpace(row[c]) != "" && !isNumeric(row[c]) {
+				numeric = false
+				break
+			}
+		}
+		if !numeric {
+			quasiIdentifiers = append(quasiIdentifiers, c)
+		}
+	}
+	if len(quasiIdentifiers) == 0 {
+		return
+	}
+	groups := map[string]int64{}
+	for _, row := range t.Rows {
+		key := make([]string, 0, len(quasiIdentifiers))
+		for _, c := range quasiIdentifiers {
+			key = append(key, row[c])
+		}
+		groups[strings.Join(key, "\x00")]++
+	}
+	small := []string{}
+	for key, size := range groups {
+		if size < k {
+			small = append(small, key)
+		}
+	}
+	if len(small) == 0 {
+		return
+	}
+	sort.Strings(small)
+	names := []string{}
+	for _, c := range quasiIdentifiers {
+		names = append(names, t.Header[c])
+	}
+	r.add(CheckKAnonymity, t.Name, "%d of %d groups of (%s) have fewer than %d rows",
+		len(small), len(groups), strings.Join(names, ", "), k)
+}
+
+// checkIdentifierColumns finds columns where almost every row has its own value. In aggregated tables
+// only columns named like identifiers are checked, the group keys of an aggregation are distinct by design
+func checkIdentifierColumns(r *Report, t *Table, maxDistinctRatio float64) {
+	if maxDistinctRatio <= 0 || len(t.Rows) < minRowsForCardinality {
+		return
+	}
+	aggregated := false
+	for _, name := range t.Header {
+		if isCountColumn(name) {
+			aggregated = true
+		}
+	}
+	for c, name := range t.Header {
+		named := identifierColumnPattern.MatchString(strings.TrimSpace(name))
+		if aggregated && !named {
+			continue
+		}
+		distinct := map[string]bool{}
+		numeric := true
+		for _, row := range t.Rows {
+			value := strings.TrimSpace(row[c])
+			if value == "" {
+				continue
+			}
+			distinct[value] = true
+			if numeric && !isNumeric(value) {
+				numeric = false
+			}
+		}
+		// measures are distinct without identifying anybody
+		if numeric && !named {
+			continue
+		}
+		ratio := float64(len(distinct)) / float64(len(t.Rows))
+		if ratio >= maxDistinctRatio {
+			r.add(CheckIdentifier, fmt.Sprintf("%s column %s", t.Name, name),
+				"%d distinct values in %d rows, the column looks like an identifier", len(distinct), len(t.Rows))
+		}
+	}
+}
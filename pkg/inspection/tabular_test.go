@@ -0,0 +1,119 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspection
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// rowsOf builds n CSV rows from the index of each row
+func rowsOf(n int, row func(i int) string) []string {
+	rows := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		rows = append(rows, row(i))
+	}
+	return rows
+}
+
+func TestInspectRowCount(t *testing.T) {
+	limits := Limits{MinRowCount: 10}
+	row := func(i int) string { return fmt.Sprintf("%d,%d", i%3, i*7) }
+	inspect(t, "out.csv", csvOf(append([]string{"segment,score"}, rowsOf(10, row)...)...), limits, nil, CheckRowCount, 0)
+	r := inspect(t, "out.csv", csvOf(append([]string{"segment,score"}, rowsOf(9, row)...)...), limits, nil, CheckRowCount, 1)
+	if !strings.Contains(r.Findings[0].Message, "9 rows is below the minimum 10") {
+		t.Fatalf("unexpected message %s", r.Findings[0].Message)
+	}
+	// an aggregated table is checked for small cells instead
+	inspect(t, "out.csv", csvOf("city,count", "springfield,12"), limits, nil, CheckRowCount, 0)
+}
+
+func TestInspectSmallCells(t *testing.T) {
+	limits := Limits{MinCellCount: 10}
+	inspect(t, "out.csv", csvOf("city,count", "springfield,12", "shelbyville,0", "ogdenville,10"),
+		limits, nil, CheckSmallCell, 0)
+	r := inspect(t, "out.csv", csvOf("city,n_visits", "springfield,12", "shelbyville,3"),
+		limits, nil, CheckSmallCell, 1)
+	if r.Findings[0].Location != "out.csv row 2 column n_visits" {
+		t.Fatalf("unexpected location %s", r.Findings[0].Location)
+	}
+	// the findings of a kind are capped
+	row := func(i int) string { return fmt.Sprintf("city%d,1", i) }
+	r = inspect(t, "out.csv", csvOf(append([]string{"city,count"}, rowsOf(25, row)...)...),
+		limits, nil, CheckSmallCell, maxFindingsOfKind+1)
+	if last := r.Findings[maxFindingsOfKind]; last.Message != "5 more small cells" {
+		t.Fatalf("unexpected message %s", last.Message)
+	}
+}
+
+func TestInspectKAnonymity(t *testing.T) {
+	limits := Limits{KAnonymity: 3}
+	rows := []string{"city,band,score",
+		"springfield,young,1", "springfield,young,2", "springfield,young,3",
+		"shelbyville,old,4", "shelbyville,old,5", "shelbyville,old,6"}
+	inspect(t, "out.csv", csvOf(rows...), limits, nil, CheckKAnonymity, 0)
+	r := inspect(t, "out.csv", csvOf(append(rows, "springfield,old,7")...), limits, nil, CheckKAnonymity, 1)
+	if want := "1 of 3 groups of (city, band) have fewer than 3 rows"; r.Findings[0].Message != want {
+		t.Fatalf("got message %s, want %s", r.Findings[0].Message, want)
+	}
+}
+
+func TestInspectIdentifierColumns(t *testing.T) {
+	limits := Limits{MaxDistinctRatio: 0.9}
+	tests := []struct {
+		name    string
+		header  string
+		row     func(i int) string
+		rows    int
+		want    int
+		wantCol string
+	}{
+		{name: "few distinct values", header: "segment,score", rows: 10,
+			row: func(i int) string { return fmt.Sprintf("segment%d,%d", i%3, i) }},
+		{name: "distinct values", header: "segment,score", rows: 10,
+			row: func(i int) string { return fmt.Sprintf("segment%d,%d", i, i) }, want: 1, wantCol: "segment"},
+		{name: "numeric identifier", header: "user_id,score", rows: 10,
+			row: func(i int) string { return fmt.Sprintf("%d,%d", 1000+i, i%2) }, want: 1, wantCol: "user_id"},
+		{name: "too few rows", header: "segment,score", rows: 9,
+			row: func(i int) string { return fmt.Sprintf("segment%d,%d", i, i) }},
+		{name: "group keys of an aggregation", header: "city,count", rows: 10,
+			row: func(i int) string { return fmt.Sprintf("city%d,%d", i, 100+i) }},
+		{name: "identifier in an aggregation", header: "email,count", rows: 10,
+			row: func(i int) string { return fmt.Sprintf("user%d@example.com,%d", i, 100+i) }, want: 1, wantCol: "email"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := csvOf(append([]string{tt.header}, rowsOf(tt.rows, tt.row)...)...)
+			r := inspect(t, "out.csv", content, limits, nil, CheckIdentifier, tt.want)
+			if tt.want > 0 && r.Findings[0].Location != "out.csv column "+tt.wantCol {
+				t.Fatalf("unexpected location %s", r.Findings[0].Location)
+			}
+		})
+	}
+}
+
+func TestReadTable(t *testing.T) {
+	table, err := ReadTable("out.CSV", csvOf("city,count", "springfield,12"))
+	if err != nil {
+		t.Fatalf("failed to read table: %v", err)
+	}
+	if len(table.Header) != 2 || len(table.Rows) != 1 || table.Rows[0][0] != "springfield" {
+		t.Fatalf("unexpected table %+v", table)
+	}
+	if table, err = ReadTable("out.txt", []byte("hello")); table != nil || err != nil {
+		t.Fatalf("read a text output as a table: %+v %v", table, err)
+	}
+}
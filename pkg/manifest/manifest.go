@@ -0,0 +1,208 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const maxPathLength = 127
+
+// Artifact is an output file of a job, Path is relative to the working directory of the job
+type Artifact struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	Sha256 string `json:"sha256"`
+}
+
+// Manifest lists the outputs of a job with their SHA-256 hashes. The root hash is part of the commitment hashed
+// into the nonce of the attestation token of the job, so the token attests every output in the manifest
+type Manifest struct {
+	Root      string     `json:"root"`
+	Artifacts []Artifact `json:"artifacts"`
+}
+
+// New hashes the outputs and builds their manifest, contents maps the path of each output to its content
+func New(contents map[string][]byte) (*Manifest, error) {
+	m := &Manifest{Artifacts: []Artifact{}}
+	for p, content := range contents {
+		if err := checkPath(p); err != nil {
+			return nil, err
+		}
+		digest := sha256.Sum256(content)
+		m.Artifacts = append(m.Artifacts, Artifact{Path: p, Size: int64(len(content)), Sha256: hex.EncodeToString(digest[:])})
+	}
+	sort.Slice(m.Artifacts, func(i, j int) bool {
+		return m.Artifacts[i].Path < m.Artifacts[j].Path
+	})
+	m.Root = RootHash(m.Artifacts)
+	return m, nil
+}
+
+// HashDir builds the manifest of the files under dir as listed by ListFiles, with paths relative to dir.
+// Files are hashed as streams, large files such as datasets are never held in memory
+func HashDir(dir string) (*Manifest, error) {
+	files, err := ListFiles([]string{dir})
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{Artifacts: []Artifact{}}
+	for _, f := range files {
+		rel, err := filepath.Rel(dir, f)
+		if err != nil {
+			return nil, err
+		}
+		a, err := hashFile(f)
+		if err != nil {
+			return nil, err
+		}
+		a.Path = filepath.ToSlash(rel)
+		m.Artifacts = append(m.Artifacts, *a)
+	}
+	sort.Slice(m.Artifacts, func(i, j int) bool {
+		return m.Artifacts[i].Path < m.Artifacts[j].Path
+	})
+	m.Root = RootHash(m.Artifacts)
+	return m, nil
+}
+
+// HashFile hashes a file as a stream
+func HashFile(fileName string) (string, error) {
+	a, err := hashFile(fileName)
+	if err != nil {
+		return "", err
+	}
+	return a.Sha256, nil
+}
+
+func hashFile(fileName string) (*Artifact, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return nil, fmt.Errorf("hashing %s: %w", fileName, err)
+	}
+	return &Artifact{Path: fileName, Size: size, Sha256: hex.EncodeToString(hash.Sum(nil))}, nil
+}
+
+// RootHash is the SHA-256 hash of the artifacts listed in the sha256sum format, one "<sha256>  <path>" line
+// per artifact in path order. It can be recomputed with `sha256sum <paths> | sha256sum`
+func RootHash(artifacts []Artifact) string {
+	hash := sha256.New()
+	for _, a := range artifacts {
+		fmt.Fprintf(hash, "%s  %s\n", a.Sha256, a.Path)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Parse reads a manifest and checks that its root hash matches its artifacts
+func Parse(content []byte) (*Manifest, error) {
+	var m Manifest
+	if err := json.Unmarshal(content, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	for i, a := range m.Artifacts {
+		if err := checkPath(a.Path); err != nil {
+			return nil, err
+		}
+		if i > 0 && m.Artifacts[i-1].Path >= a.Path {
+			return nil, fmt.Errorf("artifacts of the manifest are not sorted by path or not unique at %s", a.Path)
+		}
+	}
+	if root := RootHash(m.Artifacts); root != m.Root {
+		return nil, fmt.Errorf("root hash %s of the manifest doesn't match its artifacts, expected %s", m.Root, root)
+	}
+	return &m, nil
+}
+
+// Get returns the artifact with the path
+func (m *Manifest) Get(p string) (*Artifact, bool) {
+	i := sort.Search(len(m.Artifacts), func(i int) bool {
+		return m.Artifacts[i].Path >= p
+	})
+	if i < len(m.Artifacts) && m.Artifacts[i].Path == p {
+		return &m.Artifacts[i], true
+	}
+	return nil, false
+}
+
+// Verify checks the content of an output against its hash in the manifest
+func (m *Manifest) Verify(p string, content []byte) error {
+	a, ok := m.Get(p)
+	if !ok {
+		return fmt.Errorf("%s is not in the manifest", p)
+	}
+	digest := sha256.Sum256(content)
+	if sum := hex.EncodeToString(digest[:]); sum != a.Sha256 {
+		return fmt.Errorf("sha256 %s of %s doesn't match %s in the manifest", sum, p, a.Sha256)
+	}
+	return nil
+}
+
+// ListFiles returns the outputs of a job: the given files and the regular files under the given directories.
+// Hidden files and directories, such as notebook checkpoints, and symbolic links are skipped
+func ListFiles(paths []string) ([]string, error) {
+	res := []string{}
+	for _, root := range paths {
+		err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if p != root && strings.HasPrefix(d.Name(), ".") {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !d.Type().IsRegular() {
+				return nil
+			}
+			res = append(res, path.Clean(filepath.ToSlash(p)))
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing outputs in %s: %w", root, err)
+		}
+	}
+	sort.Strings(res)
+	return slices.Compact(res), nil
+}
+
+// checkPath only allows relative paths that stay in the working directory of the job, the API stores them
+// as file names of at most maxPathLength bytes
+func checkPath(p string) error {
+	if len(p) > maxPathLength {
+		return fmt.Errorf("artifact path %q is longer than %d bytes", p, maxPathLength)
+	}
+	if p == "" || path.IsAbs(p) || path.Clean(p) != p || p == ".." || strings.HasPrefix(p, "../") {
+		return fmt.Errorf("invalid artifact path %q", p)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// hardware models reported by confidential space in the hwmodel claim
+var hardwareModels = map[string]bool{
+	"GCP_AMD_SEV":     true,
+	"GCP_AMD_SEV_ES":  true,
+	"GCP_AMD_SEV_SNP": true,
+	"GCP_INTEL_TDX":   true,
+}
+
+// network modes of the confidential VMs, reported in the NETWORK_MODE environment override
+var networkModes = map[string]bool{
+	"private": true,
+	"proxy":   true,
+	"open":    true,
+}
+
+var regionPattern = regexp.MustCompile(`^[a-z]+-[a-z]+[0-9]+$`)
+
+// Policy is the attestation policy a data provider attaches to its data.
+// Hardware, launcher and region constraints are compiled into the attribute condition
+// of the workload identity pool provider, creator and base image constraints are checked
+// against the job when it's compiled.
+//
+//	hardware_models: [GCP_AMD_SEV, GCP_INTEL_TDX]
+//	min_launcher_version: 240500
+//	regions: [us-central1]
+//	network_modes: [private]
+//	allowed_base_images: [us-docker.pkg.dev/project/repo/base]
+//	allowed_creators: [alice]
+//	conditions:
+//	  - assertion.submods.container.restart_policy == 'Never'
+type Policy struct {
+	HardwareModels     []string `yaml:"hardware_models"`
+	MinLauncherVersion int64    `yaml:"min_launcher_version"`
+	Regions            []string `yaml:"regions"`
+	NetworkModes       []string `yaml:"network_modes"`
+	AllowedBaseImages  []string `yaml:"allowed_base_images"`
+	AllowedCreators    []string `yaml:"allowed_creators"`
+	Conditions         []string `yaml:"conditions"`
+}
+
+// JobFacts are the properties of a job known before it runs
+type JobFacts struct {
+	Creator             string
+	BaseImageRepository string
+	BaseImageDigest     string
+	// HardwareModel is the hwmodel claim of the confidential instance type the job runs on
+	HardwareModel string
+	// NetworkMode is the egress of the confidential VM of the job
+	NetworkMode string
+}
+
+// Parse decodes and validates a policy document, unknown fields are rejected
+func Parse(document []byte) (*Policy, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(document))
+	decoder.KnownFields(true)
+	p := &Policy{}
+	if err := decoder.Decode(p); err != nil {
+		return nil, errors.Wrap(err, "failed to parse policy document")
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Validate checks every constraint, so a stored policy always compiles
+func (p *Policy) Validate() error {
+	for _, model := range p.HardwareModels {
+		if !hardwareModels[model] {
+			return fmt.Errorf("unknown hardware model %s", model)
+		}
+	}
+	for _, mode := range p.NetworkModes {
+		if !networkModes[mode] {
+			return fmt.Errorf("unknown network mode %s", mode)
+		}
+	}
+	if p.MinLauncherVersion < 0 {
+		return fmt.Errorf("invalid min launcher version %d", p.MinLauncherVersion)
+	}
+	for _, region := range p.Regions {
+		if !regionPattern.MatchString(region) {
+			return fmt.Errorf("invalid region %s", region)
+		}
+	}
+	for _, image := range p.AllowedBaseImages {
+		if image == "" || strings.ContainsAny(image, " '\"") {
+			return fmt.Errorf("invalid base image %q", image)
+		}
+	}
+	for _, creator := range p.AllowedCreators {
+		if creator == "" {
+			return errors.New("empty creator in allowed creators")
+		}
+	}
+	env, err := cel.NewEnv(cel.Variable("assertion", cel.DynType))
+	if err != nil {
+		return errors.Wrap(err, "failed to create cel environment")
+	}
+	for _, condition := range p.Conditions {
+		ast, issues := env.Compile(condition)
+		if issues != nil && issues.Err() != nil {
+			return errors.Wrapf(issues.Err(), "invalid condition %q", condition)
+		}
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return fmt.Errorf("condition %q must be a boolean expression", condition)
+		}
+	}
+	return nil
+}
+
+// Compile checks the job against the policy and returns the attribute condition
+// the attestation of the job must satisfy, it's empty if the policy has no such constraints
+func (p *Policy) Compile(facts JobFacts) (string, error) {
+	if !p.AllowsCreator(facts.Creator) {
+		return "", fmt.Errorf("creator %s is not allowed by the policy", facts.Creator)
+	}
+	if len(p.AllowedBaseImages) > 0 && !p.allowsBaseImage(facts.BaseImageRepository, facts.BaseImageDigest) {
+		return "", fmt.Errorf("base image %s@%s is not allowed by the policy", facts.BaseImageRepository, facts.BaseImageDigest)
+	}
+	if len(p.HardwareModels) > 0 && facts.HardwareModel != "" && !contains(p.HardwareModels, facts.HardwareModel) {
+		return "", fmt.Errorf("hardware model %s is not allowed by the policy", facts.HardwareModel)
+	}
+	if len(p.NetworkModes) > 0 && facts.NetworkMode != "" && !contains(p.NetworkModes, facts.NetworkMode) {
+		return "", fmt.Errorf("network mode %s is not allowed by the policy", facts.NetworkMode)
+	}
+	conditions := []string{}
+	if len(p.HardwareModels) > 0 {
+		conditions = append(conditions, fmt.Sprintf("assertion.hwmodel in [%s]", quoteList(p.HardwareModels)))
+	}
+	if len(p.NetworkModes) > 0 {
+		conditions = append(conditions, fmt.Sprintf("assertion.submods.container.env_override.NETWORK_MODE in [%s]", quoteList(p.NetworkModes)))
+	}
+	if p.MinLauncherVersion > 0 {
+		conditions = append(conditions, fmt.Sprintf("assertion.swversion.exists(v, int(v) >= %d)", p.MinLauncherVersion))
+	}
+	if len(p.Regions) > 0 {
+		regions := append([]string{}, p.Regions...)
+		sort.Strings(regions)
+		conditions = append(conditions, fmt.Sprintf("assertion.submods.gce.zone.matches('^(%s)-[a-z]$')", strings.Join(regions, "|")))
+	}
+	for _, condition := range p.Conditions {
+		conditions = append(conditions, fmt.Sprintf("(%s)", condition))
+	}
+	return strings.Join(conditions, " && "), nil
+}
+
+// AllowsCreator reports whether the user may use the data, every user is allowed if no creator is listed
+func (p *Policy) AllowsCreator(creator string) bool {
+	return len(p.AllowedCreators) == 0 || contains(p.AllowedCreators, creator)
+}
+
+// allowsBaseImage matches the base image by repository, or by repository and digest if the entry is pinned
+func (p *Policy) allowsBaseImage(repository string, digest string) bool {
+	for _, image := range p.AllowedBaseImages {
+		allowedRepository, allowedDigest, pinned := strings.Cut(image, "@")
+		if allowedRepository != repository {
+			continue
+		}
+		if !pinned || allowedDigest == digest {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteList(list []string) string {
+	quoted := make([]string, 0, len(list))
+	for _, item := range list {
+		quoted = append(quoted, fmt.Sprintf("'%s'", item))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,307 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+)
+
+const (
+	testRepository = "us-docker.pkg.dev/project/repo/base"
+	testDigest     = "sha256:0123456789abcdef"
+)
+
+func TestParse(t *testing.T) {
+	p, err := Parse([]byte("hardware_models: [GCP_INTEL_TDX]\nregions: [us-central1]\n"))
+	if err != nil {
+		t.Fatalf("failed to parse policy: %v", err)
+	}
+	if len(p.HardwareModels) != 1 || len(p.Regions) != 1 {
+		t.Fatalf("unexpected policy %+v", p)
+	}
+	if _, err = Parse([]byte("hardware_model: [GCP_INTEL_TDX]\n")); err == nil {
+		t.Fatal("parsed a policy with an unknown field")
+	}
+	if _, err = Parse([]byte("network_modes: [public]\n")); err == nil {
+		t.Fatal("parsed an invalid policy")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  Policy
+		wantErr string
+	}{
+		{name: "empty", policy: Policy{}},
+		{name: "every constraint", policy: Policy{
+			HardwareModels:     []string{"GCP_AMD_SEV", "GCP_AMD_SEV_SNP", "GCP_INTEL_TDX"},
+			MinLauncherVersion: 240500,
+			Regions:            []string{"us-central1", "europe-west4"},
+			NetworkModes:       []string{"private", "proxy"},
+			AllowedBaseImages:  []string{testRepository, testRepository + "@" + testDigest},
+			AllowedCreators:    []string{"alice"},
+			Conditions:         []string{"assertion.submods.container.restart_policy == 'Never'"},
+		}},
+		{name: "unknown hardware model", policy: Policy{HardwareModels: []string{"GCP_ARM_CCA"}},
+			wantErr: "unknown hardware model GCP_ARM_CCA"},
+		{name: "unknown network mode", policy: Policy{NetworkModes: []string{"public"}},
+			wantErr: "unknown network mode public"},
+		{name: "negative launcher version", policy: Policy{MinLauncherVersion: -1},
+			wantErr: "invalid min launcher version"},
+		{name: "upper case region", policy: Policy{Regions: []string{"US-central1"}},
+			wantErr: "invalid region"},
+		{name: "zone as region", policy: Policy{Regions: []string{"us-central1-a"}},
+			wantErr: "invalid region"},
+		{name: "region escaping the pattern", policy: Policy{Regions: []string{"us-central1|.*"}},
+			wantErr: "invalid region"},
+		{name: "region escaping the string", policy: Policy{Regions: []string{"us-central1') || ('"}},
+			wantErr: "invalid region"},
+		{name: "single quote in base image", policy: Policy{AllowedBaseImages: []string{"repo/base'"}},
+			wantErr: "invalid base image"},
+		{name: "double quote in base image", policy: Policy{AllowedBaseImages: []string{`repo/base"`}},
+			wantErr: "invalid base image"},
+		{name: "space in base image", policy: Policy{AllowedBaseImages: []string{"repo/base latest"}},
+			wantErr: "invalid base image"},
+		{name: "empty base image", policy: Policy{AllowedBaseImages: []string{""}},
+			wantErr: "invalid base image"},
+		{name: "empty creator", policy: Policy{AllowedCreators: []string{""}},
+			wantErr: "empty creator"},
+		{name: "condition escaping its parentheses", policy: Policy{Conditions: []string{"true) || (true"}},
+			wantErr: "invalid condition"},
+		{name: "incomplete condition", policy: Policy{Conditions: []string{"assertion.hwmodel =="}},
+			wantErr: "invalid condition"},
+		{name: "integer condition", policy: Policy{Conditions: []string{"1 + 2"}},
+			wantErr: "must be a boolean expression"},
+		{name: "string condition", policy: Policy{Conditions: []string{"'true'"}},
+			wantErr: "must be a boolean expression"},
+		{name: "claim condition", policy: Policy{Conditions: []string{"assertion.dbgstat"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("failed to validate policy: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Policy
+		want   string
+	}{
+		{name: "empty", policy: Policy{}, want: ""},
+		{name: "creators and base images only", policy: Policy{
+			AllowedCreators: []string{"alice"}, AllowedBaseImages: []string{testRepository}}, want: ""},
+		{name: "hardware models", policy: Policy{HardwareModels: []string{"GCP_AMD_SEV", "GCP_INTEL_TDX"}},
+			want: "assertion.hwmodel in ['GCP_AMD_SEV', 'GCP_INTEL_TDX']"},
+		{name: "network modes", policy: Policy{NetworkModes: []string{"private"}},
+			want: "assertion.submods.container.env_override.NETWORK_MODE in ['private']"},
+		{name: "min launcher version", policy: Policy{MinLauncherVersion: 240500},
+			want: "assertion.swversion.exists(v, int(v) >= 240500)"},
+		{name: "regions", policy: Policy{Regions: []string{"us-east1", "europe-west4"}},
+			want: "assertion.submods.gce.zone.matches('^(europe-west4|us-east1)-[a-z]$')"},
+		{name: "conditions", policy: Policy{Conditions: []string{"assertion.dbgstat == 'disabled-since-boot'"}},
+			want: "(assertion.dbgstat == 'disabled-since-boot')"},
+		{name: "every constraint", policy: Policy{
+			HardwareModels:     []string{"GCP_INTEL_TDX"},
+			NetworkModes:       []string{"private"},
+			MinLauncherVersion: 240500,
+			Regions:            []string{"us-central1"},
+			Conditions:         []string{"assertion.submods.container.restart_policy == 'Never'"},
+		}, want: "assertion.hwmodel in ['GCP_INTEL_TDX']" +
+			" && assertion.submods.container.env_override.NETWORK_MODE in ['private']" +
+			" && assertion.swversion.exists(v, int(v) >= 240500)" +
+			" && assertion.submods.gce.zone.matches('^(us-central1)-[a-z]$')" +
+			" && (assertion.submods.container.restart_policy == 'Never')"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(); err != nil {
+				t.Fatalf("failed to validate policy: %v", err)
+			}
+			got, err := tt.policy.Compile(JobFacts{Creator: "alice", BaseImageRepository: testRepository})
+			if err != nil {
+				t.Fatalf("failed to compile policy: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got condition %q, want %q", got, tt.want)
+			}
+			if got != "" {
+				compileCondition(t, got)
+			}
+		})
+	}
+}
+
+// TestCompileEvaluates checks that the compiled condition accepts the attestation it describes, and only that one
+func TestCompileEvaluates(t *testing.T) {
+	p := Policy{
+		HardwareModels:     []string{"GCP_INTEL_TDX"},
+		NetworkModes:       []string{"private"},
+		MinLauncherVersion: 240500,
+		Regions:            []string{"us-central1"},
+		Conditions:         []string{"assertion.submods.container.restart_policy == 'Never'"},
+	}
+	condition, err := p.Compile(JobFacts{Creator: "alice"})
+	if err != nil {
+		t.Fatalf("failed to compile policy: %v", err)
+	}
+	program := compileCondition(t, condition)
+	newAssertion := func(change func(map[string]any)) map[string]any {
+		assertion := map[string]any{
+			"hwmodel":   "GCP_INTEL_TDX",
+			"swversion": []any{"240500"},
+			"submods": map[string]any{
+				"gce": map[string]any{"zone": "us-central1-a"},
+				"container": map[string]any{
+					"restart_policy": "Never",
+					"env_override":   map[string]any{"NETWORK_MODE": "private"},
+				},
+			},
+		}
+		if change != nil {
+			change(assertion)
+		}
+		return assertion
+	}
+	submod := func(a map[string]any, name string) map[string]any {
+		return a["submods"].(map[string]any)[name].(map[string]any)
+	}
+	tests := []struct {
+		name   string
+		change func(map[string]any)
+		want   bool
+	}{
+		{name: "matching attestation", want: true},
+		{name: "other hardware model", change: func(a map[string]any) { a["hwmodel"] = "GCP_AMD_SEV" }},
+		{name: "old launcher", change: func(a map[string]any) { a["swversion"] = []any{"240400"} }},
+		{name: "other region", change: func(a map[string]any) { submod(a, "gce")["zone"] = "us-east1-b" }},
+		{name: "region prefix", change: func(a map[string]any) { submod(a, "gce")["zone"] = "us-central10-a" }},
+		{name: "other network mode", change: func(a map[string]any) {
+			submod(a, "container")["env_override"] = map[string]any{"NETWORK_MODE": "open"}
+		}},
+		{name: "failed condition", change: func(a map[string]any) { submod(a, "container")["restart_policy"] = "Always" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, _, err := program.Eval(map[string]any{"assertion": newAssertion(tt.change)})
+			if err != nil {
+				t.Fatalf("failed to evaluate condition: %v", err)
+			}
+			if out.Value() != tt.want {
+				t.Fatalf("got %v, want %v", out.Value(), tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileChecksJob(t *testing.T) {
+	p := Policy{
+		HardwareModels:    []string{"GCP_INTEL_TDX"},
+		NetworkModes:      []string{"private"},
+		AllowedCreators:   []string{"alice"},
+		AllowedBaseImages: []string{testRepository + "@" + testDigest, "us-docker.pkg.dev/project/repo/unpinned"},
+	}
+	facts := JobFacts{
+		Creator:             "alice",
+		BaseImageRepository: testRepository,
+		BaseImageDigest:     testDigest,
+		HardwareModel:       "GCP_INTEL_TDX",
+		NetworkMode:         "private",
+	}
+	tests := []struct {
+		name    string
+		change  func(*JobFacts)
+		wantErr string
+	}{
+		{name: "allowed job"},
+		{name: "unknown facts", change: func(f *JobFacts) { f.HardwareModel, f.NetworkMode = "", "" }},
+		{name: "other creator", change: func(f *JobFacts) { f.Creator = "bob" },
+			wantErr: "creator bob is not allowed"},
+		{name: "other digest of a pinned base image", change: func(f *JobFacts) { f.BaseImageDigest = "sha256:fedcba" },
+			wantErr: "base image " + testRepository + "@sha256:fedcba is not allowed"},
+		{name: "other base image", change: func(f *JobFacts) { f.BaseImageRepository = "docker.io/library/python" },
+			wantErr: "is not allowed by the policy"},
+		{name: "any digest of an unpinned base image", change: func(f *JobFacts) {
+			f.BaseImageRepository, f.BaseImageDigest = "us-docker.pkg.dev/project/repo/unpinned", "sha256:fedcba"
+		}},
+		{name: "other hardware model", change: func(f *JobFacts) { f.HardwareModel = "GCP_AMD_SEV" },
+			wantErr: "hardware model GCP_AMD_SEV is not allowed"},
+		{name: "other network mode", change: func(f *JobFacts) { f.NetworkMode = "open" },
+			wantErr: "network mode open is not allowed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := facts
+			if tt.change != nil {
+				tt.change(&f)
+			}
+			_, err := p.Compile(f)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("failed to compile policy: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAllowsCreator(t *testing.T) {
+	if !(&Policy{}).AllowsCreator("bob") {
+		t.Fatal("a policy without creators doesn't allow every creator")
+	}
+	p := &Policy{AllowedCreators: []string{"alice"}}
+	if !p.AllowsCreator("alice") || p.AllowsCreator("bob") {
+		t.Fatal("the policy doesn't allow only its creators")
+	}
+}
+
+// compileCondition compiles the attribute condition as the workload identity pool provider would
+func compileCondition(t *testing.T, condition string) cel.Program {
+	t.Helper()
+	env, err := cel.NewEnv(cel.Variable("assertion", cel.DynType))
+	if err != nil {
+		t.Fatalf("failed to create cel environment: %v", err)
+	}
+	ast, issues := env.Compile(condition)
+	if issues != nil && issues.Err() != nil {
+		t.Fatalf("failed to compile condition %q: %v", condition, issues.Err())
+	}
+	if ast.OutputType() != cel.BoolType {
+		t.Fatalf("condition %q is a %v, want a boolean", condition, ast.OutputType())
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		t.Fatalf("failed to create program: %v", err)
+	}
+	return program
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provenance
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	StatementType      = "https://in-toto.io/Statement/v1"
+	SlsaPredicateType  = "https://slsa.dev/provenance/v1"
+	BuildType          = "https://github.com/tiktok-privacy-innovation/PrivacyGo-DataCleanRoom/build/v1"
+	ProvenanceMimeType = "application/vnd.in-toto+json"
+)
+
+// BuildInputs records everything that goes into a job image, hashes are hex encoded sha256
+type BuildInputs struct {
+	UUID             string            `json:"uuid"`
+	Creator          string            `json:"creator"`
+	Builder          string            `json:"builder"`
+	BuilderImage     string            `json:"builder_image"`
+	BaseImage        string            `json:"base_image"`
+	BaseImageDigest  string            `json:"base_image_digest"`
+	Workspace        string            `json:"workspace"`
+	WorkspaceSha256  string            `json:"workspace_sha256"`
+	Notebook         string            `json:"notebook"`
+	NotebookSha256   string            `json:"notebook_sha256"`
+	DockerfileSha256 string            `json:"dockerfile_sha256"`
+	BuildArgs        map[string]string `json:"build_args"`
+	Files            []File            `json:"files"`
+	StartedOn        time.Time         `json:"started_on"`
+}
+
+type File struct {
+	Path   string `json:"path"`
+	Sha256 string `json:"sha256"`
+}
+
+type Statement struct {
+	Type          string     `json:"_type"`
+	Subject       []Subject  `json:"subject"`
+	PredicateType string     `json:"predicateType"`
+	Predicate     Provenance `json:"predicate"`
+}
+
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type Provenance struct {
+	BuildDefinition BuildDefinition `json:"buildDefinition"`
+	RunDetails      RunDetails      `json:"runDetails"`
+}
+
+type BuildDefinition struct {
+	BuildType            string                 `json:"buildType"`
+	ExternalParameters   map[string]interface{} `json:"externalParameters"`
+	InternalParameters   map[string]interface{} `json:"internalParameters,omitempty"`
+	ResolvedDependencies []ResourceDescriptor   `json:"resolvedDependencies"`
+}
+
+type ResourceDescriptor struct {
+	Name   string            `json:"name,omitempty"`
+	URI    string            `json:"uri,omitempty"`
+	Digest map[string]string `json:"digest"`
+}
+
+type RunDetails struct {
+	Builder  Builder       `json:"builder"`
+	Metadata BuildMetadata `json:"metadata"`
+}
+
+type Builder struct {
+	ID string `json:"id"`
+}
+
+type BuildMetadata struct {
+	InvocationID string `json:"invocationId"`
+	StartedOn    string `json:"startedOn"`
+	FinishedOn   string `json:"finishedOn"`
+}
+
+// NewProvenance creates a SLSA v1 provenance statement for the image, imageDigest is sha256:<hex>
+func NewProvenance(image string, imageDigest string, inputs *BuildInputs, finishedOn time.Time) ([]byte, error) {
+	statement := Statement{
+		Type: StatementType,
+		Subject: []Subject{
+			{Name: imageRepository(image), Digest: digestSet(imageDigest)},
+		},
+		PredicateType: SlsaPredicateType,
+		Predicate: Provenance{
+			BuildDefinition: BuildDefinition{
+				BuildType: BuildType,
+				ExternalParameters: map[string]interface{}{
+					"creator":   inputs.Creator,
+					"notebook":  inputs.Notebook,
+					"buildArgs": inputs.BuildArgs,
+				},
+				InternalParameters: map[string]interface{}{
+					"builder": inputs.Builder,
+				},
+				ResolvedDependencies: []ResourceDescriptor{
+					{Name: "base-image", URI: "oci://" + imageRepository(inputs.BaseImage), Digest: digestSet(inputs.BaseImageDigest)},
+					{Name: "workspace", URI: inputs.Workspace, Digest: map[string]string{"sha256": inputs.WorkspaceSha256}},
+					{Name: "notebook", URI: inputs.Notebook, Digest: map[string]string{"sha256": inputs.NotebookSha256}},
+					{Name: "Dockerfile", Digest: map[string]string{"sha256": inputs.DockerfileSha256}},
+				},
+			},
+			RunDetails: RunDetails{
+				Builder: Builder{ID: inputs.BuilderImage},
+				Metadata: BuildMetadata{
+					InvocationID: inputs.UUID,
+					StartedOn:    inputs.StartedOn.UTC().Format(time.RFC3339),
+					FinishedOn:   finishedOn.UTC().Format(time.RFC3339),
+				},
+			},
+		},
+	}
+	content, err := json.Marshal(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal provenance")
+	}
+	return content, nil
+}
+
+// digestSet converts sha256:<hex> to the in-toto digest set
+func digestSet(digest string) map[string]string {
+	algorithm, value, found := strings.Cut(digest, ":")
+	if !found {
+		return map[string]string{"sha256": digest}
+	}
+	return map[string]string{algorithm: value}
+}
+
+// imageRepository strips the tag and digest of an image reference
+func imageRepository(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
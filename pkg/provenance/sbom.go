@@ -0,0 +1,133 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provenance
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	SbomMimeType = "application/spdx+json"
+)
+
+type SpdxDocument struct {
+	SpdxVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      SpdxCreationInfo   `json:"creationInfo"`
+	Packages          []SpdxPackage      `json:"packages"`
+	Files             []SpdxFile         `json:"files"`
+	Relationships     []SpdxRelationship `json:"relationships"`
+}
+
+type SpdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type SpdxPackage struct {
+	SPDXID                string         `json:"SPDXID"`
+	Name                  string         `json:"name"`
+	VersionInfo           string         `json:"versionInfo,omitempty"`
+	DownloadLocation      string         `json:"downloadLocation"`
+	FilesAnalyzed         bool           `json:"filesAnalyzed"`
+	Checksums             []SpdxChecksum `json:"checksums,omitempty"`
+	PrimaryPackagePurpose string         `json:"primaryPackagePurpose,omitempty"`
+}
+
+type SpdxFile struct {
+	SPDXID    string         `json:"SPDXID"`
+	FileName  string         `json:"fileName"`
+	Checksums []SpdxChecksum `json:"checksums"`
+}
+
+type SpdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type SpdxRelationship struct {
+	SpdxElementId      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSpdxElement string `json:"relatedSpdxElement"`
+}
+
+// NewSbom creates a SPDX 2.3 document of the job image, listing the base image and every file of the build context
+func NewSbom(image string, imageDigest string, inputs *BuildInputs, created time.Time) ([]byte, error) {
+	doc := SpdxDocument{
+		SpdxVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              imageRepository(image),
+		DocumentNamespace: fmt.Sprintf("https://spdx.org/spdxdocs/dcr-%s-%s", inputs.UUID, strings.TrimPrefix(imageDigest, "sha256:")),
+		CreationInfo: SpdxCreationInfo{
+			Created:  created.UTC().Format(time.RFC3339),
+			Creators: []string{"Organization: PrivacyGo Data Clean Room", "Tool: dcr_api"},
+		},
+		Packages: []SpdxPackage{
+			{
+				SPDXID:                "SPDXRef-Image",
+				Name:                  imageRepository(image),
+				VersionInfo:           imageDigest,
+				DownloadLocation:      "NOASSERTION",
+				Checksums:             []SpdxChecksum{{Algorithm: "SHA256", ChecksumValue: strings.TrimPrefix(imageDigest, "sha256:")}},
+				PrimaryPackagePurpose: "CONTAINER",
+			},
+			{
+				SPDXID:                "SPDXRef-BaseImage",
+				Name:                  imageRepository(inputs.BaseImage),
+				VersionInfo:           inputs.BaseImageDigest,
+				DownloadLocation:      "NOASSERTION",
+				Checksums:             []SpdxChecksum{{Algorithm: "SHA256", ChecksumValue: strings.TrimPrefix(inputs.BaseImageDigest, "sha256:")}},
+				PrimaryPackagePurpose: "CONTAINER",
+			},
+			{
+				SPDXID:                "SPDXRef-Workspace",
+				Name:                  inputs.Workspace,
+				DownloadLocation:      "NOASSERTION",
+				Checksums:             []SpdxChecksum{{Algorithm: "SHA256", ChecksumValue: inputs.WorkspaceSha256}},
+				PrimaryPackagePurpose: "ARCHIVE",
+			},
+		},
+		Relationships: []SpdxRelationship{
+			{SpdxElementId: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSpdxElement: "SPDXRef-Image"},
+			{SpdxElementId: "SPDXRef-Image", RelationshipType: "DESCENDANT_OF", RelatedSpdxElement: "SPDXRef-BaseImage"},
+			{SpdxElementId: "SPDXRef-Workspace", RelationshipType: "GENERATES", RelatedSpdxElement: "SPDXRef-Image"},
+		},
+	}
+	for i, f := range inputs.Files {
+		id := fmt.Sprintf("SPDXRef-File-%d", i)
+		doc.Files = append(doc.Files, SpdxFile{
+			SPDXID:    id,
+			FileName:  "./" + strings.TrimPrefix(f.Path, "/"),
+			Checksums: []SpdxChecksum{{Algorithm: "SHA256", ChecksumValue: f.Sha256}},
+		})
+		doc.Relationships = append(doc.Relationships, SpdxRelationship{
+			SpdxElementId: "SPDXRef-Image", RelationshipType: "CONTAINS", RelatedSpdxElement: id,
+		})
+	}
+	content, err := json.Marshal(doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal sbom")
+	}
+	return content, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Algorithm is the signature algorithm reported by confidential space in container.image_signatures
+	Algorithm = "ECDSA_P256_SHA256"
+
+	PayloadMediaType    = "application/vnd.dev.cosign.simplesigning.v1+json"
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+	sigAlgAnnotation    = "dev.cosignproject.cosign/sigalg"
+	pubKeyAnnotation    = "dev.cosignproject.cosign/pub"
+	payloadType         = "cosign container image signature"
+)
+
+// SimpleSigning is the cosign payload signed for an image
+type SimpleSigning struct {
+	Critical Critical          `json:"critical"`
+	Optional map[string]string `json:"optional"`
+}
+
+type Critical struct {
+	Identity Identity `json:"identity"`
+	Image    Image    `json:"image"`
+	Type     string   `json:"type"`
+}
+
+type Identity struct {
+	DockerReference string `json:"docker-reference"`
+}
+
+type Image struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+// NewPayload creates the payload for the image repository and digest.
+// Confidential space reads the algorithm and the public key from the optional annotations.
+func NewPayload(repository string, imageDigest string, publicKeyPem string) ([]byte, error) {
+	payload := SimpleSigning{
+		Critical: Critical{
+			Identity: Identity{DockerReference: repository},
+			Image:    Image{DockerManifestDigest: imageDigest},
+			Type:     payloadType,
+		},
+		Optional: map[string]string{
+			sigAlgAnnotation: Algorithm,
+			pubKeyAnnotation: base64.StdEncoding.EncodeToString([]byte(publicKeyPem)),
+		},
+	}
+	content, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal signing payload")
+	}
+	return content, nil
+}
+
+// Tag is where cosign stores the signature of the image digest
+func Tag(imageDigest string) string {
+	return strings.Replace(imageDigest, ":", "-", 1) + ".sig"
+}
+
+// KeyID is the hex encoded sha256 fingerprint of the DER public key, as reported by confidential space
+func KeyID(publicKeyPem string) (string, error) {
+	block, _ := pem.Decode([]byte(publicKeyPem))
+	if block == nil {
+		return "", fmt.Errorf("failed to decode public key pem")
+	}
+	sum := sha256.Sum256(block.Bytes)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Verify checks the signature of the payload and that the payload is about the image digest
+func Verify(publicKeyPem string, imageDigest string, payload []byte, signatureB64 string) error {
+	block, _ := pem.Decode([]byte(publicKeyPem))
+	if block == nil {
+		return fmt.Errorf("failed to decode public key pem")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse public key")
+	}
+	ecdsaKey, ok := publicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("public key is not an ecdsa key")
+	}
+	sig, err := base64.StdEncoding.DecodeString(signatureB64)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode signature")
+	}
+	digest := sha256.Sum256(payload)
+	if !ecdsa.VerifyASN1(ecdsaKey, digest[:], sig) {
+		return fmt.Errorf("invalid signature")
+	}
+	var simpleSigning SimpleSigning
+	if err = json.Unmarshal(payload, &simpleSigning); err != nil {
+		return errors.Wrap(err, "failed to unmarshal signing payload")
+	}
+	if simpleSigning.Critical.Image.DockerManifestDigest != imageDigest {
+		return fmt.Errorf("signature is for %s, not %s", simpleSigning.Critical.Image.DockerManifestDigest, imageDigest)
+	}
+	return nil
+}
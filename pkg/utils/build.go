@@ -0,0 +1,54 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// BuilderLabel marks kubernetes jobs created by an image builder, its value is the builder type
+	BuilderLabel = "dcr.tiktok-privacy-innovation/builder"
+	// BuildContainerName is the container whose termination message carries the image digest
+	BuildContainerName = "builder"
+
+	JobUUIDAnnotation    = "JOB_UUID"
+	JobCreatorAnnotation = "JOB_CREATOR"
+	UserTokenAnnotation  = "USER_TOKEN"
+)
+
+var digestRegexp = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// ParseBuildDigest gets the image digest from the termination message of a build container.
+// kaniko writes the bare digest, buildkit writes its metadata json.
+func ParseBuildDigest(message string) (string, error) {
+	message = strings.TrimSpace(message)
+	if strings.HasPrefix(message, "{") {
+		metadata := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(message), &metadata); err != nil {
+			return "", errors.Wrap(err, "failed to unmarshal build metadata")
+		}
+		digest, _ := metadata["containerimage.digest"].(string)
+		message = digest
+	}
+	if !digestRegexp.MatchString(message) {
+		return "", errors.Errorf("invalid image digest %q", message)
+	}
+	return message, nil
+}
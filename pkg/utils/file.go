@@ -17,6 +17,8 @@ package utils
 import (
 	"archive/tar"
 	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
 	stdErrors "errors"
 	"fmt"
 	"io"
@@ -168,3 +170,17 @@ func UnTarGz(compressedFile string, destDirectory string) error {
 	}
 	return nil
 }
+
+// GetFileSha256 returns the hex encoded sha256 of the file
+func GetFileSha256(path string) (string, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("failed to open %s", path))
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("failed to read %s", path))
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -15,8 +15,9 @@
  */
 
 locals {
-  cluster_name   = "dcr-${var.env}-cluster"
-  node_pool_name = "dcr-${var.env}-node-pool"
+  cluster_name         = "dcr-${var.env}-cluster"
+  node_pool_name       = "dcr-${var.env}-node-pool"
+  build_node_pool_name = "dcr-${var.env}-build-node-pool"
 }
 
 # GKE Cluster
@@ -62,3 +63,27 @@ resource "google_container_node_pool" "dcr_node_pool" {
     google_service_account.gcp_dcr_cluster_sa,
   ]
 }
+
+# Node pool dedicated to image builds, so a runaway build can't starve the services
+resource "google_container_node_pool" "dcr_build_node_pool" {
+  project    = var.project_id
+  name       = local.build_node_pool_name
+  location   = local.zone
+  cluster    = google_container_cluster.dcr_cluster.name
+  node_count = var.num_build_nodes
+
+  node_config {
+    service_account = google_service_account.gcp_dcr_cluster_sa.email
+    preemptible     = true
+    machine_type    = var.build_type
+    taint {
+      key    = "dcr-build"
+      value  = "true"
+      effect = "NO_SCHEDULE"
+    }
+  }
+
+  depends_on = [
+    google_service_account.gcp_dcr_cluster_sa,
+  ]
+}
@@ -107,6 +107,12 @@ resource "google_kms_key_ring_iam_member" "dcr_pod_sa_keyring_admin" {
   member      = "serviceAccount:${google_service_account.gcp_dcr_pod_sa.email}"
 }
 
+resource "google_kms_crypto_key_iam_member" "dcr_pod_sa_image_signer" {
+  crypto_key_id = google_kms_crypto_key.dcr_image_signing_key.id
+  role          = "roles/cloudkms.signerVerifier"
+  member        = "serviceAccount:${google_service_account.gcp_dcr_pod_sa.email}"
+}
+
 resource "google_project_iam_member" "dcr_pod_sa_wip_admin" {
   project = var.project_id
   role    = "roles/iam.workloadIdentityPoolAdmin"
@@ -22,3 +22,16 @@ resource "google_kms_key_ring" "dcr_key_ring" {
     prevent_destroy = false
   }
 }
+
+# Asymmetric key signing the job images, only signed images can use the workload identity providers
+resource "google_kms_crypto_key" "dcr_image_signing_key" {
+  name     = "dcr-${var.env}-image-signing-key"
+  key_ring = google_kms_key_ring.dcr_key_ring.id
+  purpose  = "ASYMMETRIC_SIGN"
+  version_template {
+    algorithm = "EC_SIGN_P256_SHA256"
+  }
+  lifecycle {
+    prevent_destroy = false
+  }
+}
@@ -44,7 +44,127 @@ resource "google_compute_subnetwork" "data_clean_room_subnetwork" {
 
   stack_type       = "IPV4_IPV6"
   ipv6_access_type = "EXTERNAL"
+  # the confidential VMs have no external IP unless they run in open mode
+  private_ip_google_access = true
 
   network = google_compute_network.data_clean_room_network.id
   depends_on = [ google_service_networking_connection.private_vpc_connection ]
 }
+
+resource "google_compute_subnetwork" "data_clean_room_tee_subnetwork" {
+  for_each      = toset(var.tee_regions)
+  name          = "dcr-${var.env}-subnetwork"
+  project       = var.project_id
+  ip_cidr_range = cidrsubnet("10.0.0.0/16", 6, index(var.tee_regions, each.value) + 1)
+  region        = each.value
+
+  stack_type       = "IPV4_IPV6"
+  ipv6_access_type = "EXTERNAL"
+  # the confidential VMs have no external IP unless they run in open mode
+  private_ip_google_access = true
+
+  network = google_compute_network.data_clean_room_network.id
+  depends_on = [ google_service_networking_connection.private_vpc_connection ]
+}
+
+# Google APIs resolve to private.googleapis.com, which is reachable without an external IP
+resource "google_dns_managed_zone" "dcr_google_apis" {
+  name       = "dcr-${var.env}-google-apis"
+  project    = var.project_id
+  dns_name   = "googleapis.com."
+  visibility = "private"
+
+  private_visibility_config {
+    networks {
+      network_url = google_compute_network.data_clean_room_network.id
+    }
+  }
+}
+
+resource "google_dns_record_set" "dcr_google_apis_private" {
+  name         = "private.googleapis.com."
+  project      = var.project_id
+  managed_zone = google_dns_managed_zone.dcr_google_apis.name
+  type         = "A"
+  ttl          = 300
+  rrdatas      = ["199.36.153.8", "199.36.153.9", "199.36.153.10", "199.36.153.11"]
+}
+
+resource "google_dns_record_set" "dcr_google_apis_wildcard" {
+  name         = "*.googleapis.com."
+  project      = var.project_id
+  managed_zone = google_dns_managed_zone.dcr_google_apis.name
+  type         = "CNAME"
+  ttl          = 300
+  rrdatas      = ["private.googleapis.com."]
+}
+
+# Artifact Registry images are pulled from pkg.dev, which is served by private.googleapis.com as well
+resource "google_dns_managed_zone" "dcr_pkg_dev" {
+  name       = "dcr-${var.env}-pkg-dev"
+  project    = var.project_id
+  dns_name   = "pkg.dev."
+  visibility = "private"
+
+  private_visibility_config {
+    networks {
+      network_url = google_compute_network.data_clean_room_network.id
+    }
+  }
+}
+
+resource "google_dns_record_set" "dcr_pkg_dev_wildcard" {
+  name         = "*.pkg.dev."
+  project      = var.project_id
+  managed_zone = google_dns_managed_zone.dcr_pkg_dev.name
+  type         = "CNAME"
+  ttl          = 300
+  rrdatas      = ["private.googleapis.com."]
+}
+
+# egress of the confidential VMs in private and proxy mode is denied but for Google APIs and the egress proxy,
+# open VMs are not restricted
+resource "google_compute_firewall" "dcr_tee_deny_egress" {
+  name               = "dcr-${var.env}-tee-deny-egress"
+  project            = var.project_id
+  network            = google_compute_network.data_clean_room_network.id
+  direction          = "EGRESS"
+  priority           = 65000
+  target_tags        = ["tee-egress-private", "tee-egress-proxy"]
+  destination_ranges = ["0.0.0.0/0"]
+
+  deny {
+    protocol = "all"
+  }
+}
+
+resource "google_compute_firewall" "dcr_tee_allow_google_apis" {
+  name               = "dcr-${var.env}-tee-allow-google-apis"
+  project            = var.project_id
+  network            = google_compute_network.data_clean_room_network.id
+  direction          = "EGRESS"
+  priority           = 1000
+  target_tags        = ["tee-egress-private", "tee-egress-proxy"]
+  destination_ranges = ["199.36.153.8/30"]
+
+  allow {
+    protocol = "tcp"
+    ports    = ["443"]
+  }
+}
+
+resource "google_compute_firewall" "dcr_tee_allow_egress_proxy" {
+  count              = var.egress_proxy_cidr == "" ? 0 : 1
+  name               = "dcr-${var.env}-tee-allow-egress-proxy"
+  project            = var.project_id
+  network            = google_compute_network.data_clean_room_network.id
+  direction          = "EGRESS"
+  priority           = 1000
+  target_tags        = ["tee-egress-proxy"]
+  destination_ranges = [var.egress_proxy_cidr]
+
+  allow {
+    protocol = "tcp"
+    ports    = [var.egress_proxy_port]
+  }
+}
@@ -62,6 +62,36 @@ variable "num_nodes" {
   default     = 2
 }
 
+variable "build_type" {
+  type        = string
+  description = "Instance type for the GKE instances running image builds"
+  default     = "n2-standard-4"
+}
+
+variable "num_build_nodes" {
+  type        = number
+  description = "Number of nodes in the node pool dedicated to image builds"
+  default     = 1
+}
+
+variable "tee_regions" {
+  type        = list(string)
+  description = "Extra regions of the candidate zones of the confidential VMs, each gets the subnetwork"
+  default     = []
+}
+
+variable "egress_proxy_cidr" {
+  type        = string
+  description = "Address range of the egress proxy of the confidential VMs in proxy mode, no proxy if empty"
+  default     = ""
+}
+
+variable "egress_proxy_port" {
+  type        = string
+  description = "Port of the egress proxy"
+  default     = "3128"
+}
+
 locals {
   zone = "${var.region}-a"
 }